@@ -22,6 +22,21 @@ It handles the normalization, parsing and generation of equivalent Go code.`,
 	packageName   string
 	typeOverrides map[string]string
 	outputPath    string
+	target        string
+	sqlOccursMode string
+	pointerWidth  int
+	stripPrefixes []string
+	abbreviations map[string]string
+	renames       map[string]string
+	unexported    bool
+	validation    bool
+	variants      bool
+	templatePath  string
+	tags          map[string]string
+	omitEmpty     map[string]string
+	decimalRules  []string
+	fieldTypes    []string
+	dateFields    []string
 )
 
 // Execute runs the root command.
@@ -36,12 +51,56 @@ func init() {
 	rootCmd.Flags().StringToStringVarP(&typeOverrides, "typeOverrides", "t", nil,
 		"Custom overrides that map PIC types to configured Go types in from=to format (e.g., unsigned=int,decimal=custom.Type)")
 	rootCmd.Flags().StringVarP(&outputPath, "output", "o", "", "Path to the output file or directory")
+	rootCmd.Flags().StringVarP(&target, "target", "f", "go", "Kind of output to generate (go, proto, avro, sql)")
+	rootCmd.Flags().StringVar(&sqlOccursMode, "sqlOccurs", "columns",
+		"How OCCURS fields are represented in SQL DDL: suffixed columns or child tables (columns, table)")
+	rootCmd.Flags().IntVar(&pointerWidth, "pointerWidth", 4,
+		"Width in bytes of POINTER and INDEX items: 4 for 31-bit or 8 for 64-bit programs (4, 8)")
+
+	rootCmd.Flags().StringSliceVar(&stripPrefixes, "stripPrefixes", nil,
+		"Prefixes stripped from identifiers in Go names (e.g., WS-,LK-)")
+	rootCmd.Flags().StringToStringVar(&abbreviations, "abbreviations", nil,
+		"Expansions of abbreviated words in Go names in from=to format (e.g., ADDR=ADDRESS,CUST=CUSTOMER)")
+	rootCmd.Flags().StringToStringVar(&renames, "renames", nil,
+		"Go names of specific identifiers in identifier=name format (e.g., WS-CUST-ADDR-LINE-1=AddressLine1)")
+	rootCmd.Flags().BoolVar(&unexported, "unexportedFields", false, "Generate unexported Go struct fields")
+	rootCmd.Flags().StringToStringVar(&tags, "tags", nil,
+		"Struct tags generated next to the pic tag and their naming convention in key=case format, where case is snake, camel or cobol (e.g., json=snake,db=cobol)")
+	rootCmd.Flags().StringToStringVar(&omitEmpty, "omitEmpty", nil,
+		"Rules for the omitempty option of the generated tags in key=rule format, where rule is never, always or redefines (e.g., json=always)")
+	rootCmd.Flags().StringSliceVar(&decimalRules, "decimalRules", nil,
+		"Go types of decimals by maximum precision and scale in precision:scale=type format, where * is any and the first matching rule applies, replacing the default 18:4=int64 (e.g., 18:2=int64,*:*=github.com/shopspring/decimal.Decimal)")
+	rootCmd.Flags().StringArrayVar(&fieldTypes, "fieldType", nil,
+		"Go type of the fields matching a qualified name, glob or /regexp/ in pattern=type format, which may be repeated (e.g., *-TS=time.Time)")
+	rootCmd.Flags().StringArrayVar(&dateFields, "dateField", nil,
+		"Date format of the fields matching a qualified name, glob or /regexp/ in pattern=format format, where format is YYYYMMDD, YYYYDDD, CYYDDD, YYYY-MM-DD or TIMESTAMP, which may be repeated (e.g., *-DATE=YYYYMMDD)")
+	rootCmd.Flags().BoolVar(&validation, "validate", false,
+		"Generate Validate methods that check the fields of Go structs against their PIC clauses and condition names")
+	rootCmd.Flags().BoolVar(&variants, "redefinesVariants", false,
+		"Generate a type holding the bytes of the fields that share them through REDEFINES, with methods that decode and encode each field as a variant")
+	rootCmd.Flags().StringVar(&templatePath, "template", "",
+		"Path to a custom text/template file that replaces the built-in Go structs template")
 
 	_ = rootCmd.MarkFlagRequired("copybook")
 }
 
 func run(_ *cobra.Command, _ []string) error {
-	cfg, err := copybooktogo.NewConfig(copybookPath, packageName, outputPath, typeOverrides)
+	cfg, err := copybooktogo.NewConfig(copybookPath, packageName, outputPath, typeOverrides,
+		copybooktogo.WithTarget(target),
+		copybooktogo.WithSQLOccursMode(sqlOccursMode),
+		copybooktogo.WithPointerWidth(pointerWidth),
+		copybooktogo.WithStripPrefixes(stripPrefixes),
+		copybooktogo.WithAbbreviations(abbreviations),
+		copybooktogo.WithRenames(renames),
+		copybooktogo.WithUnexportedFields(unexported),
+		copybooktogo.WithTags(tags, omitEmpty),
+		copybooktogo.WithDecimalRules(decimalRules),
+		copybooktogo.WithFieldTypes(fieldTypes),
+		copybooktogo.WithDateFields(dateFields),
+		copybooktogo.WithValidation(validation),
+		copybooktogo.WithRedefinesVariants(variants),
+		copybooktogo.WithTemplatePath(templatePath),
+	)
 	if err != nil {
 		return err
 	}
@@ -5,11 +5,16 @@ package copybooktogo
 import (
 	"fmt"
 	"go/token"
+	"maps"
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
+	"golang.org/x/tools/imports"
+
 	"github.com/yasv98/copybooktogo/generate"
 	"github.com/yasv98/copybooktogo/normalise"
 	"github.com/yasv98/copybooktogo/parse"
@@ -32,49 +37,442 @@ func Process(cfg *Config) error {
 		return fmt.Errorf("parsing copybook: %w", err)
 	}
 
-	data, err := generate.ToGoStructsData(ast, getCopybookName(cfg.CopybookPath), cfg.PackageName, cfg.TypeOverrides)
+	data, err := generateTarget(cfg, ast)
 	if err != nil {
-		return fmt.Errorf("generating Go structs: %w", err)
+		return fmt.Errorf("generating %s: %w", cfg.description(), err)
 	}
 
 	if err := os.WriteFile(cfg.OutputPath, data, 0o600); err != nil {
 		return fmt.Errorf("writing output file: %w", err)
 	}
 
-	fmt.Printf("Successfully generated Go structs in: %s\n", cfg.OutputPath)
+	fmt.Printf("Successfully generated %s in: %s\n", cfg.description(), cfg.OutputPath)
+	return nil
+}
+
+func generateTarget(cfg *Config, ast []*parse.Record) ([]byte, error) {
+	copybookName := getCopybookName(cfg.CopybookPath)
+	opts := []generate.Option{
+		generate.WithPointerWidth(cfg.PointerWidth),
+		generate.WithNaming(cfg.Naming),
+		generate.WithTags(cfg.Tags),
+		generate.WithFieldOverrides(cfg.FieldOverrides),
+		generate.WithDateFields(cfg.DateFields),
+		generate.WithValidation(cfg.Validation),
+		generate.WithRedefinesVariants(cfg.RedefinesVariants),
+		generate.WithWarningHandler(func(warning string) { fmt.Fprintln(os.Stderr, "Warning:", warning) }),
+	}
+	if cfg.DecimalRules != nil {
+		opts = append(opts, generate.WithDecimalRules(cfg.DecimalRules))
+	}
+	if cfg.TemplatePath != "" {
+		return generateFromTemplate(cfg, ast, copybookName, opts)
+	}
+	switch cfg.Target {
+	case TargetProto:
+		return generate.ToProto(ast, copybookName, cfg.PackageName, cfg.TypeOverrides, opts...)
+	case TargetAvro:
+		return generate.ToAvroSchema(ast, copybookName, cfg.PackageName, cfg.TypeOverrides, opts...)
+	case TargetSQL:
+		return generate.ToSQLDDL(ast, copybookName, cfg.TypeOverrides, cfg.SQLOccursMode, opts...)
+	default:
+		return generate.ToGoStructsData(ast, copybookName, cfg.PackageName, cfg.TypeOverrides, opts...)
+	}
+}
+
+// generateFromTemplate generates the output of a custom template, which is formatted as Go code when
+// it is written to a Go file.
+func generateFromTemplate(cfg *Config, ast []*parse.Record, copybookName string, opts []generate.Option) ([]byte, error) {
+	text, err := os.ReadFile(cfg.TemplatePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading template file: %w", err)
+	}
+
+	data, err := generate.ToTemplate(ast, copybookName, cfg.PackageName, cfg.TypeOverrides, string(text), opts...)
+	if err != nil {
+		return nil, err
+	}
+	if filepath.Ext(cfg.OutputPath) != ".go" {
+		return data, nil
+	}
+
+	return imports.Process(cfg.OutputPath, data, nil)
+}
+
+// description describes the output generated for a Config.
+func (cfg *Config) description() string {
+	if cfg.TemplatePath != "" {
+		return "template output"
+	}
+	return cfg.Target.description()
+}
+
+// Target defines the kind of output generated from a copybook.
+type Target string
+
+const (
+	// TargetGo generates Go struct definitions.
+	TargetGo Target = "go"
+	// TargetProto generates a Protocol Buffers schema.
+	TargetProto Target = "proto"
+	// TargetAvro generates an Avro schema.
+	TargetAvro Target = "avro"
+	// TargetSQL generates SQL DDL for landing tables.
+	TargetSQL Target = "sql"
+)
+
+var targets = []Target{TargetGo, TargetProto, TargetAvro, TargetSQL}
+
+func (t Target) description() string {
+	switch t {
+	case TargetProto:
+		return "protobuf schema"
+	case TargetAvro:
+		return "Avro schema"
+	case TargetSQL:
+		return "SQL DDL"
+	default:
+		return "Go structs"
+	}
+}
+
+func (t Target) validatePackageName(packageName string) error {
+	switch t {
+	case TargetSQL:
+		// The package name is not used for SQL DDL.
+	case TargetProto, TargetAvro:
+		// Protobuf packages and Avro namespaces are dot separated identifiers, e.g. mainframe.v1.
+		for _, part := range strings.Split(packageName, ".") {
+			if !token.IsIdentifier(part) {
+				return fmt.Errorf("package name %q is not a valid %s package", packageName, t)
+			}
+		}
+	default:
+		if !token.IsIdentifier(packageName) {
+			return fmt.Errorf("package name %q is not a valid Go identifier", packageName)
+		}
+	}
 	return nil
 }
 
+func (t Target) fileExtension() string {
+	switch t {
+	case TargetProto:
+		return ".proto"
+	case TargetAvro:
+		return ".avsc"
+	case TargetSQL:
+		return ".sql"
+	default:
+		return ".generated.go"
+	}
+}
+
 // Config holds the configuration for the copybooktogo tool.
 type Config struct {
 	CopybookPath  string
 	PackageName   string
 	TypeOverrides map[parse.PicType]string
 	OutputPath    string
+	Target        Target
+	SQLOccursMode generate.SQLOccursMode
+	PointerWidth  int
+	Naming        generate.Naming
+	Tags          []generate.Tag
+	// DecimalRules are the Go types of decimals by precision and scale, which replace the default rules.
+	DecimalRules []generate.DecimalRule
+	// FieldOverrides are the Go types of the fields matching a qualified name, glob or regular expression.
+	FieldOverrides []generate.FieldOverride
+	// DateFields are the date formats of the fields matching a qualified name, glob or regular expression.
+	DateFields []generate.DateField
+	// Validation is whether the Go structs get a Validate method.
+	Validation bool
+	// RedefinesVariants is whether the fields that share their bytes through REDEFINES are generated as
+	// variants of a type holding the bytes.
+	RedefinesVariants bool
+	// TemplatePath is the path to a custom text/template that replaces the built-in Go structs template.
+	TemplatePath string
+}
+
+// pointerWidths are the supported widths in bytes of pointers, for 31-bit and 64-bit programs.
+var pointerWidths = []int{4, 8}
+
+// Option configures optional settings of a Config.
+type Option func(*Config) error
+
+// WithTarget sets the kind of output to generate, which must be one of the supported targets.
+func WithTarget(target string) Option {
+	return func(cfg *Config) error {
+		t := Target(strings.ToLower(target))
+		if !slices.Contains(targets, t) {
+			return fmt.Errorf("%q must be a valid target: %v", target, targets)
+		}
+		cfg.Target = t
+		return nil
+	}
+}
+
+// WithSQLOccursMode sets how OCCURS fields are represented in SQL DDL, either as suffixed columns or as
+// child tables.
+func WithSQLOccursMode(mode string) Option {
+	return func(cfg *Config) error {
+		m := generate.SQLOccursMode(strings.ToLower(mode))
+		if !slices.Contains(generate.SQLOccursModeValues(), m) {
+			return fmt.Errorf("%q must be a valid SQL occurs mode: %v", mode, generate.SQLOccursModeValues())
+		}
+		cfg.SQLOccursMode = m
+		return nil
+	}
+}
+
+// WithPointerWidth sets the width in bytes of POINTER, FUNCTION-POINTER and INDEX items, which is 4 for
+// 31-bit programs and 8 for 64-bit programs.
+func WithPointerWidth(width int) Option {
+	return func(cfg *Config) error {
+		if !slices.Contains(pointerWidths, width) {
+			return fmt.Errorf("%d must be a valid pointer width: %v", width, pointerWidths)
+		}
+		cfg.PointerWidth = width
+		return nil
+	}
+}
+
+// WithStripPrefixes sets the prefixes stripped from identifiers before they are converted to Go names,
+// e.g. WS- to name WS-CUST-NAME CustName.
+func WithStripPrefixes(prefixes []string) Option {
+	return func(cfg *Config) error {
+		if slices.Contains(prefixes, "") {
+			return fmt.Errorf("prefixes to strip must not be empty: %q", prefixes)
+		}
+		cfg.Naming.Prefixes = prefixes
+		return nil
+	}
+}
+
+// WithAbbreviations sets the expansions of abbreviated words of identifiers used in Go names, e.g.
+// ADDR=ADDRESS to name CUST-ADDR CustAddress.
+func WithAbbreviations(abbreviations map[string]string) Option {
+	return func(cfg *Config) error {
+		for abbreviation, expansion := range abbreviations {
+			if abbreviation == "" || expansion == "" {
+				return fmt.Errorf("abbreviation %q=%q must not be empty", abbreviation, expansion)
+			}
+		}
+		cfg.Naming.Abbreviations = abbreviations
+		return nil
+	}
+}
+
+// WithRenames sets the Go names of specific identifiers, which replace the other naming rules.
+func WithRenames(renames map[string]string) Option {
+	return func(cfg *Config) error {
+		for identifier, name := range renames {
+			if !token.IsIdentifier(name) {
+				return fmt.Errorf("rename of %s to %q is not a valid Go identifier", identifier, name)
+			}
+		}
+		cfg.Naming.Renames = renames
+		return nil
+	}
+}
+
+// WithUnexportedFields sets whether the fields of generated Go structs are unexported.
+func WithUnexportedFields(unexported bool) Option {
+	return func(cfg *Config) error {
+		cfg.Naming.Unexported = unexported
+		return nil
+	}
+}
+
+// WithValidation sets whether generated Go structs get a Validate method, which checks their fields
+// against the constraints of their PIC clauses and condition names.
+func WithValidation(validation bool) Option {
+	return func(cfg *Config) error {
+		cfg.Validation = validation
+		return nil
+	}
+}
+
+// WithRedefinesVariants sets whether the fields of generated Go structs that share their bytes through
+// REDEFINES are replaced by a type holding the bytes, with methods that decode and encode each of them.
+func WithRedefinesVariants(variants bool) Option {
+	return func(cfg *Config) error {
+		cfg.RedefinesVariants = variants
+		return nil
+	}
+}
+
+// WithTags sets the struct tags that generated Go fields get in addition to the pic tag, from the naming
+// convention of each tag key, e.g. json=snake, and the omitempty rule of tag keys, e.g. json=always. The
+// tags are ordered by key.
+func WithTags(tags, omitEmpty map[string]string) Option {
+	return func(cfg *Config) error {
+		for key, rule := range omitEmpty {
+			if _, ok := tags[key]; !ok {
+				return fmt.Errorf("omitempty rule %s=%s is for a tag that is not generated", key, rule)
+			}
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(tags)) {
+			if key == "" || key == "pic" || strings.ContainsAny(key, " :\"`") {
+				return fmt.Errorf("%q must be a valid struct tag key other than pic", key)
+			}
+			tagCase := generate.TagCase(strings.ToLower(tags[key]))
+			if !slices.Contains(generate.TagCaseValues(), tagCase) {
+				return fmt.Errorf("%q must be a valid tag naming convention: %v", tags[key], generate.TagCaseValues())
+			}
+			omit := generate.OmitEmptyNever
+			if rule, ok := omitEmpty[key]; ok {
+				omit = generate.OmitEmpty(strings.ToLower(rule))
+				if !slices.Contains(generate.OmitEmptyValues(), omit) {
+					return fmt.Errorf("%q must be a valid omitempty rule: %v", rule, generate.OmitEmptyValues())
+				}
+			}
+			cfg.Tags = append(cfg.Tags, generate.Tag{Key: key, Case: tagCase, OmitEmpty: omit})
+		}
+		return nil
+	}
+}
+
+// WithDecimalRules sets the rules that map decimals to Go types by their precision and scale, in
+// precision:scale=type format where the precision and scale are maximums or * for any, e.g. 18:4=int64.
+// The first rule that matches a decimal applies, and the rules replace generate.DefaultDecimalRules.
+func WithDecimalRules(rules []string) Option {
+	return func(cfg *Config) error {
+		for _, rule := range rules {
+			decimalRule, err := parseDecimalRule(rule)
+			if err != nil {
+				return err
+			}
+			cfg.DecimalRules = append(cfg.DecimalRules, decimalRule)
+		}
+		return nil
+	}
+}
+
+func parseDecimalRule(rule string) (generate.DecimalRule, error) {
+	limits, goType, ok := strings.Cut(rule, "=")
+	precision, scale, hasScale := strings.Cut(limits, ":")
+	if !ok || !hasScale || goType == "" {
+		return generate.DecimalRule{}, fmt.Errorf("decimal rule %q must be in precision:scale=type format", rule)
+	}
+
+	maxPrecision, err := parseDecimalLimit(precision)
+	if err != nil {
+		return generate.DecimalRule{}, fmt.Errorf("decimal rule %q has an invalid precision: %w", rule, err)
+	}
+	maxScale, err := parseDecimalLimit(scale)
+	if err != nil {
+		return generate.DecimalRule{}, fmt.Errorf("decimal rule %q has an invalid scale: %w", rule, err)
+	}
+
+	return generate.DecimalRule{MaxPrecision: maxPrecision, MaxScale: maxScale, GoType: goType}, nil
+}
+
+// parseDecimalLimit parses the maximum precision or scale of a decimal rule, where * does not limit it.
+func parseDecimalLimit(limit string) (int, error) {
+	if limit == "*" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(limit)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%q must be a positive integer or *", limit)
+	}
+	return n, nil
+}
+
+// WithFieldTypes sets the Go types of the fields matching a pattern, in pattern=type format, e.g.
+// *-TS=time.Time. The pattern is a name qualified by the names of its groups, a glob or a regular
+// expression between slashes, and the first pattern that matches a field applies.
+func WithFieldTypes(fieldTypes []string) Option {
+	return func(cfg *Config) error {
+		for _, fieldType := range fieldTypes {
+			i := strings.LastIndex(fieldType, "=")
+			if i < 0 {
+				return fmt.Errorf("field type %q must be in pattern=type format", fieldType)
+			}
+			override, err := generate.NewFieldOverride(fieldType[:i], fieldType[i+1:])
+			if err != nil {
+				return err
+			}
+			cfg.FieldOverrides = append(cfg.FieldOverrides, override)
+		}
+		return nil
+	}
+}
+
+// WithDateFields sets the date formats of the fields matching a pattern, in pattern=format format, e.g.
+// *-DATE=YYYYMMDD. The format is matched case-insensitively, and the first matching pattern applies.
+func WithDateFields(dateFields []string) Option {
+	return func(cfg *Config) error {
+		for _, dateField := range dateFields {
+			i := strings.LastIndex(dateField, "=")
+			if i < 0 {
+				return fmt.Errorf("date field %q must be in pattern=format format", dateField)
+			}
+			field, err := generate.NewDateField(dateField[:i], generate.DateFormat(strings.ToUpper(dateField[i+1:])))
+			if err != nil {
+				return err
+			}
+			cfg.DateFields = append(cfg.DateFields, field)
+		}
+		return nil
+	}
+}
+
+// WithTemplatePath sets a custom text/template file that replaces the built-in Go structs template. The
+// template is executed with the same model as the built-in template, and its output may be of any kind.
+func WithTemplatePath(templatePath string) Option {
+	return func(cfg *Config) error {
+		if templatePath == "" {
+			return nil
+		}
+		if _, err := os.Stat(templatePath); err != nil {
+			return fmt.Errorf("template file path error: %w", err)
+		}
+		cfg.TemplatePath = templatePath
+		return nil
+	}
 }
 
 // NewConfig creates new Config and validates it.
-func NewConfig(copybookPath, packageName, outputPath string, typeOverrides map[string]string) (*Config, error) {
+//
+// The type overrides apply to the type mapping table of the configured target.
+func NewConfig(copybookPath, packageName, outputPath string, typeOverrides map[string]string, opts ...Option) (*Config, error) {
 	if _, err := os.Stat(copybookPath); err != nil {
 		return nil, fmt.Errorf("copybook file path error: %w", err)
 	}
 
-	if !token.IsIdentifier(packageName) {
-		return nil, fmt.Errorf("package name %q is not a valid Go identifier", packageName)
-	}
-
 	overrides, err := convertTypeOverrides(typeOverrides)
 	if err != nil {
 		return nil, err
 	}
 
-	outputPath = determineOutputPath(outputPath, copybookPath)
-
 	cfg := &Config{
 		CopybookPath:  copybookPath,
 		PackageName:   packageName,
 		TypeOverrides: overrides,
-		OutputPath:    outputPath,
+		Target:        TargetGo,
+		SQLOccursMode: generate.SQLOccursColumns,
+		PointerWidth:  pointerWidths[0],
+	}
+	for _, opt := range opts {
+		if err := opt(cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.TemplatePath != "" && cfg.Target != TargetGo {
+		return nil, fmt.Errorf("a custom template can only be used with the %s target", TargetGo)
+	}
+	if err := cfg.Target.validatePackageName(packageName); err != nil {
+		return nil, err
+	}
+
+	cfg.OutputPath = determineOutputPath(outputPath, copybookPath, cfg.Target)
+	// The output of a custom template may be of any kind, so an output path with any extension is a file.
+	if cfg.TemplatePath != "" && filepath.Ext(outputPath) != "" {
+		cfg.OutputPath = outputPath
 	}
 	return cfg, nil
 }
@@ -96,17 +494,17 @@ func getCopybookName(filePath string) string {
 	return strings.TrimSuffix(fileNameWithExtension, filepath.Ext(fileNameWithExtension))
 }
 
-func createGoFileName(filePath string) string {
-	return filepath.Join(path.Dir(filePath), strings.ToLower(getCopybookName(filePath))+".generated.go")
+func createOutputFileName(filePath string, target Target) string {
+	return filepath.Join(path.Dir(filePath), strings.ToLower(getCopybookName(filePath))+target.fileExtension())
 }
 
-func determineOutputPath(outputPath, copybookPath string) string {
+func determineOutputPath(outputPath, copybookPath string, target Target) string {
 	if outputPath == "" {
-		return createGoFileName(copybookPath)
+		return createOutputFileName(copybookPath, target)
 	}
-	if filepath.Ext(outputPath) == ".go" {
+	if filepath.Ext(outputPath) == filepath.Ext(target.fileExtension()) {
 		return outputPath
 	}
 
-	return filepath.Join(outputPath, strings.ToLower(getCopybookName(copybookPath))+".generated.go")
+	return filepath.Join(outputPath, strings.ToLower(getCopybookName(copybookPath))+target.fileExtension())
 }
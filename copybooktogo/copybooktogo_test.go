@@ -2,12 +2,14 @@ package copybooktogo
 
 import (
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yasv98/copybooktogo/generate"
 	"github.com/yasv98/copybooktogo/parse"
 )
 
@@ -23,6 +25,7 @@ func TestNewConfig(t *testing.T) {
 		copybookPath   string
 		packageName    string
 		typeOverrides  map[string]string
+		opts           []Option
 		expectedConfig *Config
 		assertError    assert.ErrorAssertionFunc
 	}{
@@ -34,6 +37,9 @@ func TestNewConfig(t *testing.T) {
 				CopybookPath:  tmpFile.Name(),
 				PackageName:   "validpackage",
 				TypeOverrides: map[parse.PicType]string{},
+				Target:        TargetGo,
+				SQLOccursMode: generate.SQLOccursColumns,
+				PointerWidth:  4,
 			},
 			assertError: assert.NoError,
 		},
@@ -51,9 +57,326 @@ func TestNewConfig(t *testing.T) {
 					parse.Unsigned: "int",
 					parse.Decimal:  "string",
 				},
+				Target:        TargetGo,
+				SQLOccursMode: generate.SQLOccursColumns,
+				PointerWidth:  4,
 			},
 			assertError: assert.NoError,
 		},
+		"ValidConfigWithProtoTarget_ReturnsConfigWithTarget": {
+			copybookPath: tmpFile.Name(),
+			packageName:  "mainframe.v1",
+			opts:         []Option{WithTarget("PROTO")},
+			expectedConfig: &Config{
+				CopybookPath:  tmpFile.Name(),
+				PackageName:   "mainframe.v1",
+				TypeOverrides: map[parse.PicType]string{},
+				Target:        TargetProto,
+				SQLOccursMode: generate.SQLOccursColumns,
+				PointerWidth:  4,
+			},
+			assertError: assert.NoError,
+		},
+		"ValidConfigWithSQLTarget_ReturnsConfigWithOccursMode": {
+			copybookPath: tmpFile.Name(),
+			packageName:  "validpackage",
+			opts:         []Option{WithTarget("sql"), WithSQLOccursMode("Table")},
+			expectedConfig: &Config{
+				CopybookPath:  tmpFile.Name(),
+				PackageName:   "validpackage",
+				TypeOverrides: map[parse.PicType]string{},
+				Target:        TargetSQL,
+				SQLOccursMode: generate.SQLOccursTable,
+				PointerWidth:  4,
+			},
+			assertError: assert.NoError,
+		},
+		"ValidConfigWithPointerWidth_ReturnsConfigWithPointerWidth": {
+			copybookPath: tmpFile.Name(),
+			packageName:  "validpackage",
+			opts:         []Option{WithPointerWidth(8)},
+			expectedConfig: &Config{
+				CopybookPath:  tmpFile.Name(),
+				PackageName:   "validpackage",
+				TypeOverrides: map[parse.PicType]string{},
+				Target:        TargetGo,
+				SQLOccursMode: generate.SQLOccursColumns,
+				PointerWidth:  8,
+			},
+			assertError: assert.NoError,
+		},
+		"ValidConfigWithNaming_ReturnsConfigWithNaming": {
+			copybookPath: tmpFile.Name(),
+			packageName:  "validpackage",
+			opts: []Option{
+				WithStripPrefixes([]string{"WS-"}),
+				WithAbbreviations(map[string]string{"ADDR": "ADDRESS"}),
+				WithRenames(map[string]string{"WS-CUST-ADDR-LINE-1": "AddressLine1"}),
+				WithUnexportedFields(true),
+			},
+			expectedConfig: &Config{
+				CopybookPath:  tmpFile.Name(),
+				PackageName:   "validpackage",
+				TypeOverrides: map[parse.PicType]string{},
+				Target:        TargetGo,
+				SQLOccursMode: generate.SQLOccursColumns,
+				PointerWidth:  4,
+				Naming: generate.Naming{
+					Prefixes:      []string{"WS-"},
+					Abbreviations: map[string]string{"ADDR": "ADDRESS"},
+					Renames:       map[string]string{"WS-CUST-ADDR-LINE-1": "AddressLine1"},
+					Unexported:    true,
+				},
+			},
+			assertError: assert.NoError,
+		},
+		"ValidConfigWithValidation_ReturnsConfigWithValidation": {
+			copybookPath: tmpFile.Name(),
+			packageName:  "validpackage",
+			opts:         []Option{WithValidation(true)},
+			expectedConfig: &Config{
+				CopybookPath:  tmpFile.Name(),
+				PackageName:   "validpackage",
+				TypeOverrides: map[parse.PicType]string{},
+				Target:        TargetGo,
+				SQLOccursMode: generate.SQLOccursColumns,
+				PointerWidth:  4,
+				Validation:    true,
+			},
+			assertError: assert.NoError,
+		},
+		"ValidConfigWithRedefinesVariants_ReturnsConfigWithRedefinesVariants": {
+			copybookPath: tmpFile.Name(),
+			packageName:  "validpackage",
+			opts:         []Option{WithRedefinesVariants(true)},
+			expectedConfig: &Config{
+				CopybookPath:      tmpFile.Name(),
+				PackageName:       "validpackage",
+				TypeOverrides:     map[parse.PicType]string{},
+				Target:            TargetGo,
+				SQLOccursMode:     generate.SQLOccursColumns,
+				PointerWidth:      4,
+				RedefinesVariants: true,
+			},
+			assertError: assert.NoError,
+		},
+		"ValidConfigWithTemplate_ReturnsConfigWithTemplatePath": {
+			copybookPath: tmpFile.Name(),
+			packageName:  "validpackage",
+			opts:         []Option{WithTemplatePath(tmpFile.Name())},
+			expectedConfig: &Config{
+				CopybookPath:  tmpFile.Name(),
+				PackageName:   "validpackage",
+				TypeOverrides: map[parse.PicType]string{},
+				Target:        TargetGo,
+				SQLOccursMode: generate.SQLOccursColumns,
+				PointerWidth:  4,
+				TemplatePath:  tmpFile.Name(),
+			},
+			assertError: assert.NoError,
+		},
+		"InvalidTemplatePath_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithTemplatePath("/nonexistent/template.tmpl")},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"InvalidTemplateWithNonGoTarget_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithTarget("avro"), WithTemplatePath(tmpFile.Name())},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"ValidConfigWithTags_ReturnsConfigWithTagsOrderedByKey": {
+			copybookPath: tmpFile.Name(),
+			packageName:  "validpackage",
+			opts: []Option{WithTags(
+				map[string]string{"json": "snake", "db": "COBOL", "xml": "camel"},
+				map[string]string{"json": "Always", "xml": "redefines"},
+			)},
+			expectedConfig: &Config{
+				CopybookPath:  tmpFile.Name(),
+				PackageName:   "validpackage",
+				TypeOverrides: map[parse.PicType]string{},
+				Target:        TargetGo,
+				SQLOccursMode: generate.SQLOccursColumns,
+				PointerWidth:  4,
+				Tags: []generate.Tag{
+					{Key: "db", Case: generate.TagCOBOL, OmitEmpty: generate.OmitEmptyNever},
+					{Key: "json", Case: generate.TagSnake, OmitEmpty: generate.OmitEmptyAlways},
+					{Key: "xml", Case: generate.TagCamel, OmitEmpty: generate.OmitEmptyRedefines},
+				},
+			},
+			assertError: assert.NoError,
+		},
+		"InvalidTagCase_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithTags(map[string]string{"json": "kebab"}, nil)},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"InvalidTagKey_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithTags(map[string]string{"pic": "snake"}, nil)},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"InvalidOmitEmptyRule_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithTags(map[string]string{"json": "snake"}, map[string]string{"json": "sometimes"})},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"InvalidOmitEmptyForMissingTag_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithTags(nil, map[string]string{"json": "always"})},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"ValidConfigWithDecimalRules_ReturnsConfigWithRulesInOrder": {
+			copybookPath: tmpFile.Name(),
+			packageName:  "validpackage",
+			opts:         []Option{WithDecimalRules([]string{"18:4=int64", "*:*=github.com/shopspring/decimal.Decimal"})},
+			expectedConfig: &Config{
+				CopybookPath:  tmpFile.Name(),
+				PackageName:   "validpackage",
+				TypeOverrides: map[parse.PicType]string{},
+				Target:        TargetGo,
+				SQLOccursMode: generate.SQLOccursColumns,
+				PointerWidth:  4,
+				DecimalRules: []generate.DecimalRule{
+					{MaxPrecision: 18, MaxScale: 4, GoType: "int64"},
+					{GoType: "github.com/shopspring/decimal.Decimal"},
+				},
+			},
+			assertError: assert.NoError,
+		},
+		"InvalidDecimalRuleFormat_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithDecimalRules([]string{"18=int64"})},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"InvalidDecimalRuleLimit_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithDecimalRules([]string{"18:0=int64"})},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"ValidConfigWithFieldTypes_ReturnsConfigWithFieldOverridesInOrder": {
+			copybookPath: tmpFile.Name(),
+			packageName:  "validpackage",
+			opts:         []Option{WithFieldTypes([]string{"TXN-REC.WS-TXN-DATE=civil.Date", "*-TS=time.Time"})},
+			expectedConfig: &Config{
+				CopybookPath:  tmpFile.Name(),
+				PackageName:   "validpackage",
+				TypeOverrides: map[parse.PicType]string{},
+				Target:        TargetGo,
+				SQLOccursMode: generate.SQLOccursColumns,
+				PointerWidth:  4,
+				FieldOverrides: []generate.FieldOverride{
+					mustFieldOverride(t, "TXN-REC.WS-TXN-DATE", "civil.Date"),
+					mustFieldOverride(t, "*-TS", "time.Time"),
+				},
+			},
+			assertError: assert.NoError,
+		},
+		"InvalidFieldTypeFormat_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithFieldTypes([]string{"*-TS"})},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"InvalidFieldTypePattern_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithFieldTypes([]string{"/(-TS/=time.Time"})},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"ValidConfigWithDateFields_ReturnsConfigWithDateFieldsInOrder": {
+			copybookPath: tmpFile.Name(),
+			packageName:  "validpackage",
+			opts:         []Option{WithDateFields([]string{"*-DATE=yyyymmdd", "/-TS$/=TIMESTAMP"})},
+			expectedConfig: &Config{
+				CopybookPath:  tmpFile.Name(),
+				PackageName:   "validpackage",
+				TypeOverrides: map[parse.PicType]string{},
+				Target:        TargetGo,
+				SQLOccursMode: generate.SQLOccursColumns,
+				PointerWidth:  4,
+				DateFields: []generate.DateField{
+					mustDateField(t, "*-DATE", generate.DateYYYYMMDD),
+					mustDateField(t, "/-TS$/", generate.DateDB2Timestamp),
+				},
+			},
+			assertError: assert.NoError,
+		},
+		"InvalidDateFieldFormat_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithDateFields([]string{"*-DATE=DDMMYYYY"})},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"InvalidStripPrefix_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithStripPrefixes([]string{""})},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"InvalidAbbreviation_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithAbbreviations(map[string]string{"ADDR": ""})},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"InvalidRename_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithRenames(map[string]string{"WS-CUST-NAME": "cust-name"})},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"InvalidPointerWidth_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithPointerWidth(6)},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"InvalidSQLOccursMode_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithTarget("sql"), WithSQLOccursMode("rows")},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"InvalidTarget_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "validpackage",
+			opts:           []Option{WithTarget("cobol")},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
+		"InvalidProtoPackageName_ReturnsError": {
+			copybookPath:   tmpFile.Name(),
+			packageName:    "mainframe..v1",
+			opts:           []Option{WithTarget("proto")},
+			expectedConfig: nil,
+			assertError:    assert.Error,
+		},
 		"InvalidFilePath_ReturnsError": {
 			copybookPath:   "/nonexistent/path",
 			packageName:    "validpackage",
@@ -81,38 +404,79 @@ func TestNewConfig(t *testing.T) {
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			cfg, err := NewConfig(tt.copybookPath, tt.packageName, "", tt.typeOverrides)
+			cfg, err := NewConfig(tt.copybookPath, tt.packageName, "", tt.typeOverrides, tt.opts...)
 			tt.assertError(t, err)
-			assert.True(t, cmp.Equal(tt.expectedConfig, cfg, cmpopts.IgnoreFields(Config{}, "OutputPath")))
+			assert.True(t, cmp.Equal(tt.expectedConfig, cfg, cmpopts.IgnoreFields(Config{}, "OutputPath"),
+				cmp.Exporter(func(reflect.Type) bool { return true })))
 		})
 	}
 }
 
+func mustFieldOverride(t *testing.T, pattern, goType string) generate.FieldOverride {
+	t.Helper()
+	override, err := generate.NewFieldOverride(pattern, goType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return override
+}
+
+func mustDateField(t *testing.T, pattern string, format generate.DateFormat) generate.DateField {
+	t.Helper()
+	dateField, err := generate.NewDateField(pattern, format)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dateField
+}
+
 func Test_determineOutputPath(t *testing.T) {
 	tests := map[string]struct {
 		outputPath         string
 		copybookPath       string
+		target             Target
 		expectedOutputPath string
 	}{
 		"EmptyOutputPath_ReturnsGeneratedGoFileName": {
 			outputPath:         "",
 			copybookPath:       "/path/to/copybook.cpy",
+			target:             TargetGo,
 			expectedOutputPath: "/path/to/copybook.generated.go",
 		},
 		"OutputPathWithGoExtension_ReturnsOutputPath": {
 			outputPath:         "/different/path/to/output.go",
 			copybookPath:       "/path/to/copybook.cpy",
+			target:             TargetGo,
 			expectedOutputPath: "/different/path/to/output.go",
 		},
 		"OutputPathToDirectory_ReturnsOutputPath": {
 			outputPath:         "/different/path/to/output",
 			copybookPath:       "/path/to/copybook.cpy",
+			target:             TargetGo,
 			expectedOutputPath: "/different/path/to/output/copybook.generated.go",
 		},
+		"EmptyOutputPathWithProtoTarget_ReturnsProtoFileName": {
+			outputPath:         "",
+			copybookPath:       "/path/to/COPYBOOK.cpy",
+			target:             TargetProto,
+			expectedOutputPath: "/path/to/copybook.proto",
+		},
+		"OutputPathToDirectoryWithAvroTarget_ReturnsAvroFileName": {
+			outputPath:         "/different/path/to/output",
+			copybookPath:       "/path/to/copybook.cpy",
+			target:             TargetAvro,
+			expectedOutputPath: "/different/path/to/output/copybook.avsc",
+		},
+		"OutputPathWithProtoExtension_ReturnsOutputPath": {
+			outputPath:         "/different/path/to/output.proto",
+			copybookPath:       "/path/to/copybook.cpy",
+			target:             TargetProto,
+			expectedOutputPath: "/different/path/to/output.proto",
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			assert.Equal(t, tt.expectedOutputPath, determineOutputPath(tt.outputPath, tt.copybookPath))
+			assert.Equal(t, tt.expectedOutputPath, determineOutputPath(tt.outputPath, tt.copybookPath, tt.target))
 		})
 	}
 }
@@ -0,0 +1,165 @@
+package generate
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/yasv98/copybooktogo/parse"
+	"github.com/yasv98/copybooktogo/util/generic"
+)
+
+// Boundaries that synchronized records are aligned on.
+const (
+	halfword   = 2
+	fullword   = 4
+	doubleword = 8
+)
+
+// buildCopybookStructData lays out the records of a copybook and builds its struct data. This is the
+// entry point of every generator, so that they all see the same layout. The records are laid out on a
+// copy, which leaves the AST of the caller unchanged.
+func (g *goGenerator) buildCopybookStructData(copybookName string, ast []*parse.Record) ([]StructData, error) {
+	if err := g.checkRedefines(ast); err != nil {
+		return nil, err
+	}
+
+	// The records of the copybook are the children of a record holding them, so that synchronized level
+	// 01 records are aligned too.
+	copybook := &parse.Record{Identifier: copybookName, Children: cloneRecords(ast)}
+	if _, err := g.insertSlackBytes(copybook, 0); err != nil {
+		return nil, err
+	}
+	ast = copybook.Children
+
+	if err := g.resolveNames(copybookName, ast); err != nil {
+		return nil, err
+	}
+	g.qualifyNames(ast, "")
+
+	return g.buildStructData(g.naming.typeName(copybookName), copybookName, ast)
+}
+
+// insertSlackBytes follows the IBM rules for the SYNCHRONIZED clause and inserts slack bytes as FILLER
+// records, so that the layout of the generated code matches the layout of the data:
+//   - Slack bytes are inserted before a synchronized record that would otherwise not start on its
+//     boundary, relative to the start of the level 01 record.
+//   - Slack bytes are inserted at the end of each occurrence of an OCCURS group that contains
+//     synchronized records, so that every occurrence is aligned the same way as the first.
+//
+// The offset is the position of the parent record relative to the start of its level 01 record. It
+// returns the largest boundary of the synchronized records within the parent.
+func (g *goGenerator) insertSlackBytes(parent *parse.Record, offset int) (int, error) {
+	children := make([]*parse.Record, 0, len(parent.Children))
+	offsets := make(map[string]int)
+	maxBoundary := 1
+	slackCount := 0
+
+	for _, rec := range parent.Children {
+		start := offset
+		if rec.Redefines != "" {
+			start = offsets[identifierKey(rec.Redefines)]
+		}
+
+		boundary := g.alignment(rec)
+		// A redefining record starts at the same position as the record it redefines, so slack bytes
+		// cannot be inserted before it.
+		if slack := (boundary - start%boundary) % boundary; slack > 0 && rec.Redefines == "" {
+			slackCount++
+			children = append(children, g.newSlackRecord(parent, rec.Level, slack, slackCount))
+			start += slack
+		}
+
+		if len(rec.Children) > 0 {
+			// The records within a level 01 record are aligned relative to its start, as it always starts on
+			// a doubleword boundary.
+			childOffset := start
+			if parent.Level == 0 {
+				childOffset = 0
+			}
+			var err error
+			if boundary, err = g.insertSlackBytes(rec, childOffset); err != nil {
+				return 0, err
+			}
+		}
+		maxBoundary = max(maxBoundary, boundary)
+
+		size, err := g.calculateSize(rec)
+		if err != nil {
+			return 0, err
+		}
+		children = append(children, rec)
+		offsets[identifierKey(rec.Identifier)] = start
+		offset = start + size
+	}
+	parent.Children = children
+
+	if parent.OccursCount > 1 && maxBoundary > 1 {
+		size, err := g.calculateSize(parent)
+		if err != nil {
+			return 0, err
+		}
+		occurrenceSize := size / parent.OccursCount
+		if slack := (maxBoundary - occurrenceSize%maxBoundary) % maxBoundary; slack > 0 {
+			slackCount++
+			parent.Children = append(parent.Children, g.newSlackRecord(parent, children[0].Level, slack, slackCount))
+		}
+	}
+
+	return maxBoundary, nil
+}
+
+// alignment returns the boundary that a record starts on, which is 1 for records that are not
+// synchronized.
+func (g *goGenerator) alignment(rec *parse.Record) int {
+	if !rec.Synchronized || len(rec.Children) > 0 {
+		return 1
+	}
+
+	switch rec.Usage {
+	case parse.Binary, parse.NativeBinary:
+		return min(g.storageSize(rec), fullword)
+	case parse.Float:
+		return fullword
+	case parse.Double:
+		return doubleword
+	case parse.Index, parse.Pointer, parse.ProcedurePointer, parse.FunctionPointer:
+		return g.pointerWidth
+	default:
+		// SYNCHRONIZED has no effect on records stored as characters or packed decimals.
+		return 1
+	}
+}
+
+func (g *goGenerator) newSlackRecord(parent *parse.Record, level, size, slackCount int) *parse.Record {
+	rec := &parse.Record{
+		Level:      level,
+		Identifier: fmt.Sprint(parent.Identifier, "-SLACK-BYTES", slackCount),
+		Pic:        parse.Picture{PicString: fmt.Sprintf("X(%02d)", size), PicType: parse.Alpha, PicCount: size},
+	}
+	g.slackRecords[rec] = true
+	return rec
+}
+
+// withoutSlack returns the struct data without the fields of slack bytes, for the schemas of the values
+// of the fields rather than of their bytes.
+func withoutSlack(structs []StructData) []StructData {
+	return generic.Map(func(s StructData) StructData {
+		s.Fields = slices.DeleteFunc(slices.Clone(s.Fields), func(f FieldData) bool { return f.Slack })
+		return s
+	}, structs)
+}
+
+// cloneRecords returns a copy of records and of the records within them.
+func cloneRecords(records []*parse.Record) []*parse.Record {
+	if records == nil {
+		return nil
+	}
+
+	clones := make([]*parse.Record, len(records))
+	for i, rec := range records {
+		clone := *rec
+		clone.Children = cloneRecords(rec.Children)
+		clones[i] = &clone
+	}
+	return clones
+}
@@ -0,0 +1,205 @@
+package generate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+func Test_insertSlackBytes(t *testing.T) {
+	alpha := func(identifier string, count int) *parse.Record {
+		return &parse.Record{
+			Level:      5,
+			Identifier: identifier,
+			Pic:        parse.Picture{PicString: "X", PicType: parse.Alpha, PicCount: count},
+		}
+	}
+	binary := func(identifier string, digits int, synchronized bool) *parse.Record {
+		return &parse.Record{
+			Level:        5,
+			Identifier:   identifier,
+			Pic:          parse.Picture{PicString: "9", PicType: parse.Unsigned, PicCount: digits, IntegerDigits: digits},
+			Usage:        parse.Binary,
+			Synchronized: synchronized,
+		}
+	}
+	slack := func(identifier string, count int) *parse.Record {
+		return &parse.Record{
+			Level:      5,
+			Identifier: identifier,
+			Pic:        parse.Picture{PicString: fmt.Sprintf("X(%02d)", count), PicType: parse.Alpha, PicCount: count},
+		}
+	}
+
+	tests := map[string]struct {
+		input            *parse.Record
+		expectedChildren []*parse.Record
+		expectedBoundary int
+	}{
+		"HalfwordBinary_InsertsSlackBytes": {
+			input:            &parse.Record{Identifier: "GROUP", Children: []*parse.Record{alpha("A", 1), binary("B", 4, true)}},
+			expectedChildren: []*parse.Record{alpha("A", 1), slack("GROUP-SLACK-BYTES1", 1), binary("B", 4, true)},
+			expectedBoundary: halfword,
+		},
+		"FullwordBinary_InsertsSlackBytes": {
+			input:            &parse.Record{Identifier: "GROUP", Children: []*parse.Record{alpha("A", 3), binary("B", 18, true)}},
+			expectedChildren: []*parse.Record{alpha("A", 3), slack("GROUP-SLACK-BYTES1", 1), binary("B", 18, true)},
+			expectedBoundary: fullword,
+		},
+		"AlignedBinary_InsertsNoSlackBytes": {
+			input:            &parse.Record{Identifier: "GROUP", Children: []*parse.Record{alpha("A", 4), binary("B", 9, true)}},
+			expectedChildren: []*parse.Record{alpha("A", 4), binary("B", 9, true)},
+			expectedBoundary: fullword,
+		},
+		"UnsynchronizedBinary_InsertsNoSlackBytes": {
+			input:            &parse.Record{Identifier: "GROUP", Children: []*parse.Record{alpha("A", 1), binary("B", 9, false)}},
+			expectedChildren: []*parse.Record{alpha("A", 1), binary("B", 9, false)},
+			expectedBoundary: 1,
+		},
+		"OccursGroup_InsertsSlackBytesAtEndOfOccurrence": {
+			input: &parse.Record{
+				Identifier:  "GROUP",
+				OccursCount: 3,
+				Children:    []*parse.Record{binary("B", 9, true), alpha("A", 1)},
+			},
+			expectedChildren: []*parse.Record{binary("B", 9, true), alpha("A", 1), slack("GROUP-SLACK-BYTES1", 3)},
+			expectedBoundary: fullword,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := newGoGenerator(defaultTypeMapping()).insertSlackBytes(tt.input, 0)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedBoundary, got)
+			assert.Equal(t, tt.expectedChildren, tt.input.Children)
+		})
+	}
+}
+
+func Test_insertSlackBytes_NestedGroup(t *testing.T) {
+	// The nested group starts at offset 1, so its synchronized child needs 3 slack bytes to reach a
+	// fullword boundary relative to the start of the level 01 record.
+	nested := &parse.Record{
+		Level:      5,
+		Identifier: "NESTED",
+		Children: []*parse.Record{
+			{
+				Level:        10,
+				Identifier:   "B",
+				Pic:          parse.Picture{PicString: "S9(09)", PicType: parse.Signed, PicCount: 10, IntegerDigits: 9, Signed: true},
+				Usage:        parse.NativeBinary,
+				Synchronized: true,
+			},
+		},
+	}
+	root := &parse.Record{
+		Level:      1,
+		Identifier: "ROOT",
+		Children: []*parse.Record{
+			{Level: 5, Identifier: "A", Pic: parse.Picture{PicString: "X", PicType: parse.Alpha, PicCount: 1}},
+			nested,
+		},
+	}
+
+	_, err := newGoGenerator(defaultTypeMapping()).insertSlackBytes(root, 0)
+
+	assert.NoError(t, err)
+	assert.Len(t, root.Children, 2)
+	assert.Equal(t, "NESTED-SLACK-BYTES1", nested.Children[0].Identifier)
+	assert.Equal(t, 3, nested.Children[0].Pic.PicCount)
+}
+
+// synchronizedCopybook returns the records of a copybook with a synchronized level 01 record and a group
+// with a synchronized field, which both need slack bytes.
+func synchronizedCopybook() []*parse.Record {
+	binary := parse.Picture{PicString: "S9(4)", PicType: parse.Signed, PicCount: 5, IntegerDigits: 4, Signed: true}
+	return []*parse.Record{
+		{Level: 1, Identifier: "FLAG", Pic: parse.Picture{PicString: "X", PicType: parse.Alpha, PicCount: 1}},
+		{Level: 1, Identifier: "COUNTER", Pic: binary, Usage: parse.Binary, Synchronized: true},
+		{Level: 1, Identifier: "REC", Children: []*parse.Record{
+			{Level: 5, Identifier: "A", Pic: parse.Picture{PicString: "X", PicType: parse.Alpha, PicCount: 1}},
+			{Level: 5, Identifier: "B", Pic: binary, Usage: parse.Binary, Synchronized: true},
+		}},
+	}
+}
+
+func Test_buildCopybookStructData_SynchronizedRecords_Aligned(t *testing.T) {
+	structs, err := newGoGenerator(defaultTypeMapping()).buildCopybookStructData("COPYBOOK", synchronizedCopybook())
+	require.NoError(t, err)
+
+	identifiers := func(s StructData) []string {
+		var identifiers []string
+		for _, field := range s.Fields {
+			identifiers = append(identifiers, field.Identifier)
+		}
+		return identifiers
+	}
+	assert.Equal(t, []string{"FLAG", "COPYBOOK-SLACK-BYTES1", "COUNTER", "REC"}, identifiers(structs[0]))
+	assert.Equal(t, []string{"A", "REC-SLACK-BYTES1", "B"}, identifiers(structs[1]))
+	assert.True(t, structs[0].Fields[1].Slack)
+	assert.False(t, structs[0].Fields[2].Slack)
+}
+
+func Test_ToGoStructsData_SynchronizedRecords_LeavesASTUnchanged(t *testing.T) {
+	ast := synchronizedCopybook()
+
+	first, err := ToGoStructsData(ast, "Copybook", "main", nil)
+	require.NoError(t, err)
+	second, err := ToGoStructsData(ast, "Copybook", "main", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, synchronizedCopybook(), ast)
+	assert.Equal(t, string(first), string(second))
+}
+
+func Test_ToProto_SynchronizedRecords_NoSlackFields(t *testing.T) {
+	expected := `// This file is generated by copybooktogo. DO NOT EDIT.
+
+syntax = "proto3";
+
+package main;
+
+// Copybook contains a representation of Copybook
+message Copybook {
+  string flag = 1; // start:1 end:1
+  int64 counter = 2; // start:3 end:4
+  Rec rec = 3; // start:5 end:8
+}
+
+// Rec contains a representation of REC
+message Rec {
+  string a = 1; // start:5 end:5
+  int64 b = 2; // start:7 end:8
+}
+`
+
+	got, err := ToProto(synchronizedCopybook(), "Copybook", "main", nil)
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(got))
+}
+
+func Test_ToSQLDDL_SynchronizedRecords_NoSlackColumns(t *testing.T) {
+	expected := `-- This file is generated by copybooktogo. DO NOT EDIT.
+
+-- rec contains a representation of REC
+CREATE TABLE "rec" (
+    "a" VARCHAR(1), -- A PIC X
+    "b" BIGINT -- B PIC S9(4)
+);
+
+-- copybook contains a representation of Copybook
+CREATE TABLE "copybook" (
+    "flag" VARCHAR(1), -- FLAG PIC X
+    "counter" BIGINT -- COUNTER PIC S9(4)
+);
+`
+
+	got, err := ToSQLDDL(synchronizedCopybook(), "Copybook", nil, SQLOccursColumns)
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(got))
+}
@@ -0,0 +1,169 @@
+package generate
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/yasv98/copybooktogo/parse"
+	"github.com/yasv98/copybooktogo/util/generic"
+)
+
+// avroDecimalType is the type mapping value that selects the Avro decimal logical type.
+const avroDecimalType = "decimal"
+
+// maxAvroLongDigits is the number of digits that always fits into an Avro long.
+const maxAvroLongDigits = 18
+
+type avroRecord struct {
+	Type      string      `json:"type"`
+	Name      string      `json:"name"`
+	Namespace string      `json:"namespace,omitempty"`
+	Doc       string      `json:"doc,omitempty"`
+	Fields    []avroField `json:"fields"`
+}
+
+type avroField struct {
+	Name string `json:"name"`
+	Type any    `json:"type"`
+	Doc  string `json:"doc,omitempty"`
+}
+
+type avroArray struct {
+	Type  string `json:"type"`
+	Items any    `json:"items"`
+}
+
+type avroDecimal struct {
+	Type        string `json:"type"`
+	LogicalType string `json:"logicalType"`
+	Precision   int    `json:"precision"`
+	Scale       int    `json:"scale"`
+}
+
+type avroGenerator struct {
+	structs        map[string]StructData
+	picTypeMapping map[parse.PicType]string
+	// Avro named types can only be defined once, after which they must be referenced by name.
+	definedRecords map[string]bool
+	warn           func(warning string)
+}
+
+// ToAvroSchema generates an Avro schema from a COBOL copybook AST.
+//
+// Groups become nested records, OCCURS become arrays and decimals become the decimal logical type
+// with the precision and scale of their PIC clause. COBOL names are converted to Avro-legal identifiers,
+// and sibling fields whose identifiers convert to the same name, such as A-B and A:B, are numbered and
+// reported as warnings.
+func ToAvroSchema(ast []*parse.Record, copybookName, namespace string, typeOverrides map[parse.PicType]string, opts ...Option) ([]byte, error) {
+	if len(ast) == 0 {
+		return nil, fmt.Errorf("ast is empty")
+	}
+
+	goGen := newGoGenerator(defaultTypeMapping(), opts...)
+	structs, err := goGen.buildCopybookStructData(copybookName, ast)
+	if err != nil {
+		return nil, err
+	}
+	structs = withoutSlack(structs)
+
+	avroGen := avroGenerator{
+		structs: make(map[string]StructData, len(structs)),
+		// Merge default PIC type mappings with any configured overrides.
+		picTypeMapping: generic.MergeMaps(defaultAvroTypeMapping(), typeOverrides),
+		definedRecords: make(map[string]bool),
+		warn:           goGen.warn,
+	}
+	for _, s := range structs {
+		avroGen.structs[s.StructVarName] = s
+	}
+
+	schema := avroGen.buildRecord(structs[0])
+	schema.Namespace = namespace
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal avro schema: %w", err)
+	}
+
+	return append(data, '\n'), nil
+}
+
+func (g *avroGenerator) buildRecord(s StructData) avroRecord {
+	g.definedRecords[s.StructVarName] = true
+
+	usedNames := make(map[string]string)
+	fields := generic.Map(func(field FieldData) avroField {
+		f := g.buildField(field)
+		f.Name = resolveSiblingName(g.warn, "avro field", field.Identifier, f.Name, s.Identifier, usedNames)
+		return f
+	}, s.Fields)
+
+	return avroRecord{
+		Type:   "record",
+		Name:   s.StructVarName,
+		Doc:    s.Identifier,
+		Fields: fields,
+	}
+}
+
+func (g *avroGenerator) buildField(field FieldData) avroField {
+	doc := fmt.Sprint(field.Identifier, " start:", field.PicGlobalStart, " end:", field.PicGlobalEnd)
+	if field.RedefinesVarName != "" {
+		doc += " REDEFINES " + field.RedefinesVarName
+	}
+
+	var fieldType any
+	switch {
+	case field.StructVarName != "" && g.definedRecords[field.StructVarName]:
+		fieldType = field.StructVarName
+	case field.StructVarName != "":
+		fieldType = g.buildRecord(g.structs[field.StructVarName])
+	case field.Usage.IsOpaque():
+		fieldType = "bytes"
+	case avroFloatTypes[field.Usage] != "":
+		fieldType = avroFloatTypes[field.Usage]
+	default:
+		fieldType = g.elementaryType(field.Pic)
+	}
+
+	if field.OccursCount > 1 {
+		fieldType = avroArray{Type: "array", Items: fieldType}
+	}
+
+	return avroField{Name: toSnakeIdentifier(field.Identifier), Type: fieldType, Doc: doc}
+}
+
+func (g *avroGenerator) elementaryType(pic parse.Picture) any {
+	avroType, ok := g.picTypeMapping[pic.PicType]
+	if !ok {
+		// Default to string if no mapping is found.
+		avroType = "string"
+	}
+
+	// Integers that could overflow a long are widened to a decimal with no fractional digits.
+	if avroType == avroDecimalType || (avroType == "long" && pic.Precision() > maxAvroLongDigits) {
+		return avroDecimal{Type: "bytes", LogicalType: "decimal", Precision: pic.Precision(), Scale: pic.Scale()}
+	}
+
+	return avroType
+}
+
+// avroFloatTypes maps the floating-point usages, which have no PIC clause, to Avro primitive types.
+var avroFloatTypes = map[parse.Usage]string{
+	parse.Float:  "float",
+	parse.Double: "double",
+}
+
+func defaultAvroTypeMapping() map[parse.PicType]string {
+	return map[parse.PicType]string{
+		parse.Unsigned:      "long",
+		parse.Signed:        "long",
+		parse.Decimal:       avroDecimalType,
+		parse.Alpha:         "string",
+		parse.NumericEdited: "string",
+		parse.AlphaEdited:   "string",
+		parse.National:      "string",
+		parse.DBCS:          "string",
+		parse.Unknown:       "string",
+	}
+}
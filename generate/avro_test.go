@@ -0,0 +1,292 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+func Test_ToAvroSchema(t *testing.T) {
+	tests := map[string]struct {
+		input         []*parse.Record
+		typeOverrides map[parse.PicType]string
+		expected      string
+		assertError   assert.ErrorAssertionFunc
+	}{
+		"Valid_Copybook_ReturnsAvroSchema": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "RECORD-1",
+					Children: []*parse.Record{
+						{
+							Level:       5,
+							Identifier:  "RECORD-2",
+							OccursCount: 2,
+							Children: []*parse.Record{
+								{
+									Level:      7,
+									Identifier: "RECORD-3",
+									Pic:        parse.Picture{PicString: "S9(05)V99", PicType: parse.Decimal, PicCount: 8, IntegerDigits: 5, FractionDigits: 2, Signed: true},
+								},
+							},
+						},
+						{
+							Level:      5,
+							Identifier: "RECORD-4",
+							Pic:        parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2},
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			expected: `{
+  "type": "record",
+  "name": "Copybook",
+  "namespace": "mainframe.cdc",
+  "doc": "Copybook",
+  "fields": [
+    {
+      "name": "record_1",
+      "type": {
+        "type": "record",
+        "name": "Record1",
+        "doc": "RECORD-1",
+        "fields": [
+          {
+            "name": "record_2",
+            "type": {
+              "type": "array",
+              "items": {
+                "type": "record",
+                "name": "Record2",
+                "doc": "RECORD-2",
+                "fields": [
+                  {
+                    "name": "record_3",
+                    "type": {
+                      "type": "bytes",
+                      "logicalType": "decimal",
+                      "precision": 7,
+                      "scale": 2
+                    },
+                    "doc": "RECORD-3 start:1 end:8"
+                  }
+                ]
+              }
+            },
+            "doc": "RECORD-2 start:1 end:16"
+          },
+          {
+            "name": "record_4",
+            "type": "string",
+            "doc": "RECORD-4 start:17 end:18"
+          }
+        ]
+      },
+      "doc": "RECORD-1 start:1 end:18"
+    }
+  ]
+}
+`,
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithTypeOverrides_ReturnsAvroSchemaWithTypesOverridden": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "RECORD",
+					Pic:        parse.Picture{PicString: "9(03)V9", PicType: parse.Decimal, PicCount: 4, IntegerDigits: 3, FractionDigits: 1},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{
+				parse.Decimal: "double",
+			},
+			expected: `{
+  "type": "record",
+  "name": "Copybook",
+  "namespace": "mainframe.cdc",
+  "doc": "Copybook",
+  "fields": [
+    {
+      "name": "record",
+      "type": "double",
+      "doc": "RECORD start:1 end:4"
+    }
+  ]
+}
+`,
+			assertError: assert.NoError,
+		},
+		"Valid_UsageGroupAndFloatingPoint_ReturnsAvroSchema": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "RECORD-1",
+					Children: []*parse.Record{
+						{
+							Level:      5,
+							Identifier: "POINTERS",
+							Usage:      parse.Pointer,
+							Children: []*parse.Record{
+								{
+									Level:      10,
+									Identifier: "POINTER-1",
+									Usage:      parse.Pointer,
+								},
+							},
+						},
+						{
+							Level:      5,
+							Identifier: "RATE",
+							Usage:      parse.Double,
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			expected: `{
+  "type": "record",
+  "name": "Copybook",
+  "namespace": "mainframe.cdc",
+  "doc": "Copybook",
+  "fields": [
+    {
+      "name": "record_1",
+      "type": {
+        "type": "record",
+        "name": "Record1",
+        "doc": "RECORD-1",
+        "fields": [
+          {
+            "name": "pointers",
+            "type": {
+              "type": "record",
+              "name": "Pointers",
+              "doc": "POINTERS",
+              "fields": [
+                {
+                  "name": "pointer_1",
+                  "type": "bytes",
+                  "doc": "POINTER-1 start:1 end:4"
+                }
+              ]
+            },
+            "doc": "POINTERS start:1 end:4"
+          },
+          {
+            "name": "rate",
+            "type": "double",
+            "doc": "RATE start:5 end:12"
+          }
+        ]
+      },
+      "doc": "RECORD-1 start:1 end:12"
+    }
+  ]
+}
+`,
+			assertError: assert.NoError,
+		},
+		"Invalid_EmptyCopybook_ReturnsError": {
+			input:         []*parse.Record{},
+			typeOverrides: map[parse.PicType]string{},
+			assertError:   assert.Error,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ToAvroSchema(tt.input, "Copybook", "mainframe.cdc", tt.typeOverrides)
+			tt.assertError(t, err)
+			assert.Equal(t, tt.expected, string(got))
+		})
+	}
+}
+
+func Test_ToAvroSchema_DuplicateNames_Renamed(t *testing.T) {
+	input := []*parse.Record{
+		{
+			Level:      1,
+			Identifier: "RECORD",
+			Children: []*parse.Record{
+				{Level: 5, Identifier: "A-B", Pic: parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2}},
+				{Level: 5, Identifier: "A:B", Pic: parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2}},
+			},
+		},
+	}
+	expected := `{
+  "type": "record",
+  "name": "Copybook",
+  "namespace": "mainframe.cdc",
+  "doc": "Copybook",
+  "fields": [
+    {
+      "name": "record",
+      "type": {
+        "type": "record",
+        "name": "Record",
+        "doc": "RECORD",
+        "fields": [
+          {
+            "name": "a_b",
+            "type": "string",
+            "doc": "A-B start:1 end:2"
+          },
+          {
+            "name": "a_b_2",
+            "type": "string",
+            "doc": "A:B start:3 end:4"
+          }
+        ]
+      },
+      "doc": "RECORD start:1 end:4"
+    }
+  ]
+}
+`
+	var warnings []string
+
+	got, err := ToAvroSchema(input, "Copybook", "mainframe.cdc", map[parse.PicType]string{},
+		WithWarningHandler(func(warning string) { warnings = append(warnings, warning) }))
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(got))
+	assert.Equal(t, []string{
+		"field A:B of RECORD renamed from AB to AB2, as AB is already used by A-B",
+		"avro field A:B of RECORD renamed from a_b to a_b_2, as a_b is already used by A-B",
+	}, warnings)
+}
+
+func Test_avroGenerator_elementaryType(t *testing.T) {
+	tests := map[string]struct {
+		input    parse.Picture
+		expected any
+	}{
+		"Alpha": {
+			input:    parse.Picture{PicString: "X(10)", PicType: parse.Alpha},
+			expected: "string",
+		},
+		"Signed": {
+			input:    parse.Picture{PicString: "S9(18)", PicType: parse.Signed, IntegerDigits: 18, Signed: true},
+			expected: "long",
+		},
+		"UnsignedOverflowingLong": {
+			input:    parse.Picture{PicString: "9(19)", PicType: parse.Unsigned, IntegerDigits: 19},
+			expected: avroDecimal{Type: "bytes", LogicalType: "decimal", Precision: 19, Scale: 0},
+		},
+		"Decimal": {
+			input:    parse.Picture{PicString: "S9(13)V9(4)", PicType: parse.Decimal, IntegerDigits: 13, FractionDigits: 4, Signed: true},
+			expected: avroDecimal{Type: "bytes", LogicalType: "decimal", Precision: 17, Scale: 4},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := avroGenerator{picTypeMapping: defaultAvroTypeMapping()}
+			assert.Equal(t, tt.expected, g.elementaryType(tt.input))
+		})
+	}
+}
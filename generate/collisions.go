@@ -0,0 +1,157 @@
+package generate
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+// resolveNames assigns the Go names of the records of a copybook, so that every generated type and every
+// field of a struct has a unique name. The first record to use a name keeps it, so names are resolved in
+// the same order as the structs are generated, and every rename is reported as a warning:
+//   - A type whose name is already used is qualified with the name of its parent type, and numbered if
+//     the qualified name is also used.
+//   - A field whose name is already used in its struct is numbered, and a field whose name is a Go
+//     keyword gets a trailing underscore.
+func (g *goGenerator) resolveNames(copybookName string, ast []*parse.Record) error {
+	g.typeNames = make(map[*parse.Record]string)
+	g.fieldNames = make(map[*parse.Record]string)
+
+	// The copybook struct and the opaque types are generated regardless of the groups of the copybook.
+	copybookTypeName := g.naming.typeName(copybookName)
+	if copybookTypeName == "" {
+		return fmt.Errorf("copybook name %q has no letters to name its Go type", copybookName)
+	}
+	usedTypeNames := map[string]string{copybookTypeName: copybookName}
+	for _, usage := range opaqueUsagesOf(ast) {
+		usedTypeNames[opaqueTypeName(usage)] = "USAGE " + strings.ToUpper(usage.String())
+	}
+	if g.redefinesVariants {
+		g.variantsTypeNames = make(map[*parse.Record]string)
+		usedTypeNames[codecTypeName(copybookTypeName)] = "the codec of REDEFINES variants"
+	}
+
+	return g.resolveGroupNames(copybookName, copybookTypeName, ast, usedTypeNames)
+}
+
+func (g *goGenerator) resolveGroupNames(parentName, parentTypeName string, records []*parse.Record, usedTypeNames map[string]string) error {
+	fillerCount := 0
+	usedFieldNames := make(map[string]string)
+	for _, rec := range records {
+		fillerCount = g.handleFillerName(rec, parentName, fillerCount)
+
+		name := g.naming.fieldName(rec.Identifier)
+		if name == "" {
+			return fmt.Errorf("identifier %s of %s has no letters to name its Go field", rec.Identifier, parentName)
+		}
+		resolved := name
+		if token.IsKeyword(resolved) {
+			resolved += "_"
+		}
+		for i := 2; usedFieldNames[resolved] != ""; i++ {
+			resolved = fmt.Sprint(name, i)
+		}
+		if resolved != name {
+			g.warn(fmt.Sprintf("field %s of %s renamed from %s to %s, as %s", rec.Identifier, parentName, name,
+				resolved, collisionReason(name, usedFieldNames)))
+		}
+		usedFieldNames[resolved] = rec.Identifier
+		g.fieldNames[rec] = resolved
+	}
+
+	if g.redefinesVariants {
+		for _, group := range redefinitionGroups(records) {
+			base := records[group[0]]
+			g.variantsTypeNames[base] = g.resolveTypeName(base, g.naming.typeName(base.Identifier)+"Variants",
+				parentName, parentTypeName, usedTypeNames)
+		}
+	}
+
+	for _, rec := range records {
+		if len(rec.Children) == 0 {
+			continue
+		}
+
+		resolved := g.resolveTypeName(rec, g.naming.typeName(rec.Identifier), parentName, parentTypeName, usedTypeNames)
+		g.typeNames[rec] = resolved
+
+		if err := g.resolveGroupNames(rec.Identifier, resolved, rec.Children, usedTypeNames); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveTypeName resolves the name of a type generated for a record, which is qualified with the name of
+// its parent type if it is already used.
+func (g *goGenerator) resolveTypeName(rec *parse.Record, name, parentName, parentTypeName string, usedTypeNames map[string]string) string {
+	resolved := name
+	if usedTypeNames[resolved] != "" {
+		resolved = parentTypeName + name
+	}
+	qualified := resolved
+	for i := 2; usedTypeNames[resolved] != ""; i++ {
+		resolved = fmt.Sprint(qualified, i)
+	}
+	if resolved != name {
+		g.warn(fmt.Sprintf("type of %s in %s renamed from %s to %s, as %s", rec.Identifier, parentName, name,
+			resolved, collisionReason(name, usedTypeNames)))
+	}
+	usedTypeNames[resolved] = rec.Identifier
+	return resolved
+}
+
+// resolveSiblingName resolves the snake case name of a field of a schema, such as a proto field or an Avro
+// field, which is numbered if a sibling already uses it, as identifiers such as A-B and A:B have the same
+// snake case name.
+func resolveSiblingName(warn func(warning string), kind, identifier, name, parentIdentifier string, usedNames map[string]string) string {
+	resolved := name
+	for i := 2; usedNames[resolved] != ""; i++ {
+		resolved = fmt.Sprint(name, "_", i)
+	}
+	if resolved != name {
+		warn(fmt.Sprintf("%s %s of %s renamed from %s to %s, as %s", kind, identifier, parentIdentifier, name,
+			resolved, collisionReason(name, usedNames)))
+	}
+	usedNames[resolved] = identifier
+	return resolved
+}
+
+func collisionReason(name string, used map[string]string) string {
+	if identifier, ok := used[name]; ok {
+		return fmt.Sprint(name, " is already used by ", identifier)
+	}
+	return fmt.Sprint(name, " is a Go keyword")
+}
+
+// opaqueUsagesOf returns the opaque usages of the elementary records of a copybook, in declaration order.
+func opaqueUsagesOf(records []*parse.Record) []parse.Usage {
+	var usages []parse.Usage
+	for _, rec := range records {
+		if len(rec.Children) > 0 {
+			usages = append(usages, opaqueUsagesOf(rec.Children)...)
+		} else if rec.Usage.IsOpaque() {
+			usages = append(usages, rec.Usage)
+		}
+	}
+	return usages
+}
+
+// typeName returns the Go name of the type generated for a group record.
+func (g *goGenerator) typeName(rec *parse.Record) string {
+	if name, ok := g.typeNames[rec]; ok {
+		return name
+	}
+	return g.naming.typeName(rec.Identifier)
+}
+
+// fieldName returns the Go name of the field generated for a record.
+func (g *goGenerator) fieldName(rec *parse.Record) string {
+	if name, ok := g.fieldNames[rec]; ok {
+		return name
+	}
+	return g.naming.fieldName(rec.Identifier)
+}
@@ -0,0 +1,211 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+func Test_resolveNames(t *testing.T) {
+	alphanumeric := parse.Picture{PicType: parse.Alpha, PicCount: 5}
+
+	tests := map[string]struct {
+		naming             Naming
+		ast                []*parse.Record
+		expectedTypeNames  []string
+		expectedFieldNames []string
+		expectedWarnings   []string
+	}{
+		"NoCollisions": {
+			ast: []*parse.Record{
+				{Identifier: "CUSTOMER", Children: []*parse.Record{
+					{Identifier: "NAME", Pic: alphanumeric},
+					{Identifier: "ADDRESS", Children: []*parse.Record{{Identifier: "LINE", Pic: alphanumeric}}},
+				}},
+			},
+			expectedTypeNames:  []string{"Customer", "Address"},
+			expectedFieldNames: []string{"Customer", "Name", "Address", "Line"},
+		},
+		"SameGroupNameInDifferentParents_QualifiedWithParentType": {
+			ast: []*parse.Record{
+				{Identifier: "CUSTOMER", Children: []*parse.Record{
+					{Identifier: "ADDRESS", Children: []*parse.Record{{Identifier: "LINE", Pic: alphanumeric}}},
+				}},
+				{Identifier: "SUPPLIER", Children: []*parse.Record{
+					{Identifier: "ADDRESS", Children: []*parse.Record{{Identifier: "LINE", Pic: alphanumeric}}},
+				}},
+			},
+			expectedTypeNames:  []string{"Customer", "Address", "Supplier", "SupplierAddress"},
+			expectedFieldNames: []string{"Customer", "Supplier", "Address", "Line", "Address", "Line"},
+			expectedWarnings: []string{
+				"type of ADDRESS in SUPPLIER renamed from Address to SupplierAddress, as Address is already used by ADDRESS",
+			},
+		},
+		"GroupNamedAfterCopybook_QualifiedWithParentType": {
+			ast: []*parse.Record{
+				{Identifier: "RECORD", Children: []*parse.Record{
+					{Identifier: "COPYBOOK", Children: []*parse.Record{{Identifier: "LINE", Pic: alphanumeric}}},
+				}},
+			},
+			expectedTypeNames:  []string{"Record", "RecordCopybook"},
+			expectedFieldNames: []string{"Record", "Copybook", "Line"},
+			expectedWarnings: []string{
+				"type of COPYBOOK in RECORD renamed from Copybook to RecordCopybook, as Copybook is already used by COPYBOOK",
+			},
+		},
+		"GroupNamedAfterOpaqueType_QualifiedWithParentType": {
+			ast: []*parse.Record{
+				{Identifier: "RECORD", Children: []*parse.Record{
+					{Identifier: "PTR", Usage: parse.Pointer},
+					{Identifier: "POINTER", Children: []*parse.Record{{Identifier: "LINE", Pic: alphanumeric}}},
+				}},
+			},
+			expectedTypeNames:  []string{"Record", "RecordPointer"},
+			expectedFieldNames: []string{"Record", "Ptr", "Pointer", "Line"},
+			expectedWarnings: []string{
+				"type of POINTER in RECORD renamed from Pointer to RecordPointer, as Pointer is already used by USAGE POINTER",
+			},
+		},
+		"QualifiedNameAlreadyUsed_Numbered": {
+			ast: []*parse.Record{
+				{Identifier: "A", Children: []*parse.Record{
+					{Identifier: "B", Children: []*parse.Record{{Identifier: "X", Pic: alphanumeric}}},
+					{Identifier: "A-B", Children: []*parse.Record{{Identifier: "X", Pic: alphanumeric}}},
+					{Identifier: "C", Children: []*parse.Record{
+						{Identifier: "B", Children: []*parse.Record{{Identifier: "X", Pic: alphanumeric}}},
+					}},
+				}},
+				{Identifier: "A-2", Children: []*parse.Record{
+					{Identifier: "B", Children: []*parse.Record{{Identifier: "X", Pic: alphanumeric}}},
+				}},
+			},
+			expectedTypeNames: []string{"A", "B", "AB", "C", "CB", "A2", "A2B"},
+			expectedFieldNames: []string{
+				"A", "A2", "B", "AB", "C", "X", "X", "B", "X", "B", "X",
+			},
+			expectedWarnings: []string{
+				"type of B in C renamed from B to CB, as B is already used by B",
+				"type of B in A-2 renamed from B to A2B, as B is already used by B",
+			},
+		},
+		"FieldsThatSanitiseToSameName_Numbered": {
+			ast: []*parse.Record{
+				{Identifier: "RECORD", Children: []*parse.Record{
+					{Identifier: "CUST-ID", Pic: alphanumeric},
+					{Identifier: "CUST_ID", Pic: alphanumeric},
+					{Identifier: "cust-id", Pic: alphanumeric},
+				}},
+			},
+			expectedTypeNames:  []string{"Record"},
+			expectedFieldNames: []string{"Record", "CustID", "CustID2", "CustID3"},
+			expectedWarnings: []string{
+				"field CUST_ID of RECORD renamed from CustID to CustID2, as CustID is already used by CUST-ID",
+				"field cust-id of RECORD renamed from CustID to CustID3, as CustID is already used by CUST-ID",
+			},
+		},
+		"FieldsThatCollideThroughNaming_Numbered": {
+			naming: Naming{Prefixes: []string{"WS-"}},
+			ast: []*parse.Record{
+				{Identifier: "RECORD", Children: []*parse.Record{
+					{Identifier: "WS-NAME", Pic: alphanumeric},
+					{Identifier: "NAME", Pic: alphanumeric},
+				}},
+			},
+			expectedTypeNames:  []string{"Record"},
+			expectedFieldNames: []string{"Record", "Name", "Name2"},
+			expectedWarnings: []string{
+				"field NAME of RECORD renamed from Name to Name2, as Name is already used by WS-NAME",
+			},
+		},
+		"UnexportedKeyword_Suffixed": {
+			naming: Naming{Unexported: true},
+			ast: []*parse.Record{
+				{Identifier: "RECORD", Children: []*parse.Record{
+					{Identifier: "TYPE", Pic: alphanumeric},
+					{Identifier: "TYPE-", Pic: alphanumeric},
+				}},
+			},
+			expectedTypeNames:  []string{"Record"},
+			expectedFieldNames: []string{"record", "type_", "type2"},
+			expectedWarnings: []string{
+				"field TYPE of RECORD renamed from type to type_, as type is a Go keyword",
+				"field TYPE- of RECORD renamed from type to type2, as type is a Go keyword",
+			},
+		},
+		"Fillers_NotReported": {
+			ast: []*parse.Record{
+				{Identifier: "RECORD", Children: []*parse.Record{
+					{Identifier: "FILLER", Pic: alphanumeric},
+					{Identifier: "FILLER", Pic: alphanumeric},
+				}},
+			},
+			expectedTypeNames:  []string{"Record"},
+			expectedFieldNames: []string{"Record", "RecordFiller1", "RecordFiller2"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var warnings []string
+			g := newGoGenerator(defaultTypeMapping(), WithNaming(tt.naming),
+				WithWarningHandler(func(warning string) { warnings = append(warnings, warning) }))
+
+			require.NoError(t, g.resolveNames("COPYBOOK", tt.ast))
+
+			typeNames, fieldNames := resolvedNames(g, tt.ast)
+			assert.Equal(t, tt.expectedTypeNames, typeNames)
+			assert.Equal(t, tt.expectedFieldNames, fieldNames)
+			assert.Equal(t, tt.expectedWarnings, warnings)
+		})
+	}
+}
+
+func Test_resolveNames_NoLetters_ReturnsError(t *testing.T) {
+	tests := map[string]struct {
+		copybookName string
+		naming       Naming
+		ast          []*parse.Record
+		expectedErr  string
+	}{
+		"CopybookName": {
+			copybookName: "123",
+			ast:          []*parse.Record{{Identifier: "RECORD", Pic: parse.Picture{PicType: parse.Alpha, PicCount: 1}}},
+			expectedErr:  `copybook name "123" has no letters to name its Go type`,
+		},
+		"RenamedIdentifier": {
+			copybookName: "COPYBOOK",
+			naming:       Naming{Renames: map[string]string{"NAME": ""}},
+			ast: []*parse.Record{
+				{Identifier: "CUSTOMER", Children: []*parse.Record{{Identifier: "NAME", Pic: parse.Picture{PicType: parse.Alpha, PicCount: 1}}}},
+			},
+			expectedErr: "identifier NAME of CUSTOMER has no letters to name its Go field",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newGoGenerator(defaultTypeMapping(), WithNaming(tt.naming))
+			assert.EqualError(t, g.resolveNames(tt.copybookName, tt.ast), tt.expectedErr)
+		})
+	}
+}
+
+// resolvedNames returns the resolved names of the records in the order in which their structs are generated.
+func resolvedNames(g *goGenerator, records []*parse.Record) ([]string, []string) {
+	var typeNames, fieldNames []string
+	for _, rec := range records {
+		fieldNames = append(fieldNames, g.fieldName(rec))
+	}
+	for _, rec := range records {
+		if len(rec.Children) > 0 {
+			typeNames = append(typeNames, g.typeName(rec))
+			nestedTypeNames, nestedFieldNames := resolvedNames(g, rec.Children)
+			typeNames = append(typeNames, nestedTypeNames...)
+			fieldNames = append(fieldNames, nestedFieldNames...)
+		}
+	}
+	return typeNames, fieldNames
+}
@@ -0,0 +1,186 @@
+package generate
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+// DateFormat defines the format of a date held by a field.
+type DateFormat string
+
+const (
+	// DateYYYYMMDD is a date such as 20240131, held by a PIC 9(8) or PIC X(8) field.
+	DateYYYYMMDD DateFormat = "YYYYMMDD"
+	// DateYYYYDDD is a Julian date with the day of the year, such as 2024031, held by a PIC 9(7) or
+	// PIC X(7) field.
+	DateYYYYDDD DateFormat = "YYYYDDD"
+	// DateCYYDDD is a Julian date with a century digit counting the centuries since 1900, such as 124031
+	// for 2024031, held by a PIC 9(6) or PIC X(6) field. Like the other formats without separators, it
+	// may also be held by a wider numeric field, such as a PIC S9(7) COMP-3 field.
+	DateCYYDDD DateFormat = "CYYDDD"
+	// DateISO is a date such as 2024-01-31, held by a PIC X(10) field.
+	DateISO DateFormat = "YYYY-MM-DD"
+	// DateDB2Timestamp is a DB2 timestamp such as 2024-01-31-13.45.30.123456, held by a PIC X(26) field.
+	DateDB2Timestamp DateFormat = "TIMESTAMP"
+)
+
+// DateFormatValues returns all supported DateFormat values.
+func DateFormatValues() []DateFormat {
+	return []DateFormat{DateYYYYMMDD, DateYYYYDDD, DateCYYDDD, DateISO, DateDB2Timestamp}
+}
+
+// dateLayout describes how a date format is converted to and from a time.Time.
+type dateLayout struct {
+	// layout is the time.Parse layout of the format, which for CYYDDD is the layout of the date once its
+	// century digit is expanded to the century.
+	layout string
+	// width is the number of characters or digits of the format, which a numeric field may exceed.
+	width            int
+	minYear, maxYear int
+	// numeric formats only have digits, so they may be held by numeric fields.
+	numeric      bool
+	centuryDigit bool
+}
+
+var dateLayouts = map[DateFormat]dateLayout{
+	DateYYYYMMDD:     {layout: "20060102", width: 8, minYear: 1, maxYear: 9999, numeric: true},
+	DateYYYYDDD:      {layout: "2006002", width: 7, minYear: 1, maxYear: 9999, numeric: true},
+	DateCYYDDD:       {layout: "2006002", width: 6, minYear: 1900, maxYear: 2899, numeric: true, centuryDigit: true},
+	DateISO:          {layout: "2006-01-02", width: 10, minYear: 1, maxYear: 9999},
+	DateDB2Timestamp: {layout: "2006-01-02-15.04.05.000000", width: 26, minYear: 1, maxYear: 9999},
+}
+
+// integerGoTypes are the Go types of numeric fields that can hold a numeric date.
+var integerGoTypes = []string{
+	"int", "int8", "int16", "int32", "int64",
+	"uint", "uint8", "uint16", "uint32", "uint64",
+}
+
+// DateField declares the date format of the fields whose name matches a pattern, which is matched the
+// same way as the pattern of a FieldOverride.
+type DateField struct {
+	fieldPattern
+	format DateFormat
+}
+
+// NewDateField creates a DateField.
+func NewDateField(pattern string, format DateFormat) (DateField, error) {
+	if _, ok := dateLayouts[format]; !ok {
+		return DateField{}, fmt.Errorf("date format %q must be one of %v", format, DateFormatValues())
+	}
+
+	p, err := newFieldPattern(pattern)
+	if err != nil {
+		return DateField{}, fmt.Errorf("date field %w", err)
+	}
+	return DateField{fieldPattern: p, format: format}, nil
+}
+
+// dateAnnotation matches the annotation of the date format of a field in its inline comment, e.g.
+// *> @date(YYYYMMDD).
+var dateAnnotation = regexp.MustCompile(`(?i)@date\(\s*([^)\s]*)\s*\)`)
+
+// dateData represents the methods that get and set a field holding a date as a time.Time.
+type dateData struct {
+	Getter       string
+	Setter       string
+	FieldVarName string
+	GoType       string
+	Receiver     string
+	Format       DateFormat
+	Layout       string
+	Width        int
+	MinYear      int
+	MaxYear      int
+	Numeric      bool
+	CenturyDigit bool
+}
+
+// buildDates builds the date methods of the fields of a struct that hold a date, which is declared by an
+// annotation of the field or by the first date field that matches it. A field that cannot hold its date
+// format, such as a numeric field holding a format with separators, is reported as a warning.
+func (g *goGenerator) buildDates(structVarName string, records []*parse.Record, fields []FieldData) []dateData {
+	receiver := receiverName(structVarName)
+
+	var dates []dateData
+	for i, rec := range records {
+		format, ok := g.dateFormat(rec)
+		if !ok {
+			continue
+		}
+
+		field := fields[i]
+		layout := dateLayouts[format]
+		numeric := slices.Contains(integerGoTypes, field.VarType)
+		width := field.PicSize
+		if numeric {
+			width = rec.Pic.IntegerDigits
+		}
+		// A numeric field may be wider than its format, as its extra leading digits are zero.
+		fits := width == layout.width || (numeric && width > layout.width)
+		name := upperFirst(field.FieldVarName) + "Time"
+
+		var reason string
+		switch {
+		case len(rec.Children) > 0 || rec.OccursCount > 1:
+			reason = "it is a group or a table"
+		case !numeric && field.VarType != "string":
+			reason = fmt.Sprint("its Go type ", field.VarType, " is neither an integer nor a string")
+		case numeric && rec.Pic.Scale() > 0:
+			reason = fmt.Sprint("it is a decimal held in minor units by ", field.VarType)
+		case numeric && !layout.numeric:
+			reason = "its format has separators but it is numeric"
+		case !fits:
+			reason = fmt.Sprint("it has ", width, " positions but its format has ", layout.width)
+		case slices.ContainsFunc(fields, func(f FieldData) bool {
+			return f.FieldVarName == name || f.FieldVarName == "Set"+name
+		}):
+			reason = fmt.Sprint("the names of its methods are used by the fields of ", structVarName)
+		}
+		if reason != "" {
+			g.warn(fmt.Sprintf("date methods of %s are not generated, as %s", rec.Identifier, reason))
+			continue
+		}
+
+		dates = append(dates, dateData{
+			Getter:       name,
+			Setter:       "Set" + name,
+			FieldVarName: field.FieldVarName,
+			GoType:       field.VarType,
+			Receiver:     receiver,
+			Format:       format,
+			Layout:       layout.layout,
+			Width:        layout.width,
+			MinYear:      layout.minYear,
+			MaxYear:      layout.maxYear,
+			Numeric:      numeric,
+			CenturyDigit: layout.centuryDigit,
+		})
+	}
+
+	return dates
+}
+
+// dateFormat returns the date format of a record, which an annotation takes precedence over.
+func (g *goGenerator) dateFormat(rec *parse.Record) (DateFormat, bool) {
+	if match := dateAnnotation.FindStringSubmatch(rec.Comment); match != nil {
+		format := DateFormat(strings.ToUpper(match[1]))
+		if _, ok := dateLayouts[format]; !ok {
+			g.warn(fmt.Sprintf("date format %s of %s must be one of %v", match[1], rec.Identifier, DateFormatValues()))
+			return "", false
+		}
+		return format, true
+	}
+
+	qualifiedName := g.qualifiedName(rec)
+	for _, dateField := range g.dateFields {
+		if dateField.matches(qualifiedName) {
+			return dateField.format, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,144 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+func TestNewDateField(t *testing.T) {
+	tests := map[string]struct {
+		pattern     string
+		format      DateFormat
+		assertError assert.ErrorAssertionFunc
+	}{
+		"Glob":          {pattern: "*-DATE", format: DateYYYYMMDD, assertError: assert.NoError},
+		"Regexp":        {pattern: `/-TS$/`, format: DateDB2Timestamp, assertError: assert.NoError},
+		"UnknownFormat": {pattern: "*-DATE", format: "DDMMYYYY", assertError: assert.Error},
+		"EmptyPattern":  {pattern: "", format: DateYYYYMMDD, assertError: assert.Error},
+		"InvalidGlob":   {pattern: "[-DATE", format: DateYYYYMMDD, assertError: assert.Error},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewDateField(tt.pattern, tt.format)
+			tt.assertError(t, err)
+		})
+	}
+}
+
+func Test_buildDates(t *testing.T) {
+	numeric := func(digits int) parse.Picture {
+		return parse.Picture{PicType: parse.Unsigned, PicCount: digits, IntegerDigits: digits}
+	}
+	alphanumeric := func(size int) parse.Picture {
+		return parse.Picture{PicType: parse.Alpha, PicCount: size}
+	}
+
+	tests := map[string]struct {
+		record           *parse.Record
+		expected         []dateData
+		expectedWarnings []string
+	}{
+		"AnnotatedNumericField": {
+			record: &parse.Record{Identifier: "TXN-DATE", Pic: numeric(8), Comment: "@date(yyyymmdd)"},
+			expected: []dateData{{
+				Getter: "TxnDateTime", Setter: "SetTxnDateTime", FieldVarName: "TxnDate", GoType: "uint",
+				Receiver: "r", Format: DateYYYYMMDD, Layout: "20060102", Width: 8, MinYear: 1, MaxYear: 9999,
+				Numeric: true,
+			}},
+		},
+		"MatchedStringField": {
+			record: &parse.Record{Identifier: "CREATED-TS", Pic: alphanumeric(26)},
+			expected: []dateData{{
+				Getter: "CreatedTsTime", Setter: "SetCreatedTsTime", FieldVarName: "CreatedTs", GoType: "string",
+				Receiver: "r", Format: DateDB2Timestamp, Layout: "2006-01-02-15.04.05.000000", Width: 26,
+				MinYear: 1, MaxYear: 9999,
+			}},
+		},
+		"WiderNumericCenturyField": {
+			record: &parse.Record{Identifier: "JUL-DATE", Pic: numeric(7), Comment: "@date(CYYDDD)"},
+			expected: []dateData{{
+				Getter: "JulDateTime", Setter: "SetJulDateTime", FieldVarName: "JulDate", GoType: "uint",
+				Receiver: "r", Format: DateCYYDDD, Layout: "2006002", Width: 6, MinYear: 1900, MaxYear: 2899,
+				Numeric: true, CenturyDigit: true,
+			}},
+		},
+		"AnnotationOverridesPattern": {
+			record: &parse.Record{Identifier: "POST-DATE", Pic: numeric(7), Comment: "posted @date(YYYYDDD)"},
+			expected: []dateData{{
+				Getter: "PostDateTime", Setter: "SetPostDateTime", FieldVarName: "PostDate", GoType: "uint",
+				Receiver: "r", Format: DateYYYYDDD, Layout: "2006002", Width: 7, MinYear: 1, MaxYear: 9999,
+				Numeric: true,
+			}},
+		},
+		"NotADate": {
+			record: &parse.Record{Identifier: "TXN-ID", Pic: numeric(8), Comment: "the id"},
+		},
+		"UnknownAnnotationFormat_Warned": {
+			record:           &parse.Record{Identifier: "TXN-DATE", Pic: numeric(6), Comment: "@date(DDMMYY)"},
+			expectedWarnings: []string{"date format DDMMYY of TXN-DATE must be one of [YYYYMMDD YYYYDDD CYYDDD YYYY-MM-DD TIMESTAMP]"},
+		},
+		"NarrowField_Warned": {
+			record:           &parse.Record{Identifier: "TXN-DATE", Pic: numeric(6)},
+			expectedWarnings: []string{"date methods of TXN-DATE are not generated, as it has 6 positions but its format has 8"},
+		},
+		"WiderStringField_Warned": {
+			record:           &parse.Record{Identifier: "TXN-DATE", Pic: alphanumeric(10)},
+			expectedWarnings: []string{"date methods of TXN-DATE are not generated, as it has 10 positions but its format has 8"},
+		},
+		"NumericFieldWithSeparators_Warned": {
+			record:           &parse.Record{Identifier: "ISO-DATE", Pic: numeric(10), Comment: "@date(YYYY-MM-DD)"},
+			expectedWarnings: []string{"date methods of ISO-DATE are not generated, as its format has separators but it is numeric"},
+		},
+		"DecimalField_Warned": {
+			record: &parse.Record{
+				Identifier: "TXN-DATE", Comment: "@date(YYYYMMDD)",
+				Pic: parse.Picture{PicType: parse.Decimal, PicCount: 25, IntegerDigits: 8, FractionDigits: 17},
+			},
+			expectedWarnings: []string{
+				"date methods of TXN-DATE are not generated, as its Go type decimal.Decimal is neither an integer nor a string",
+			},
+		},
+		"DecimalInMinorUnits_Warned": {
+			record: &parse.Record{
+				Identifier: "TXN-DATE", Comment: "@date(YYYYMMDD)",
+				Pic: parse.Picture{PicType: parse.Decimal, PicCount: 10, IntegerDigits: 8, FractionDigits: 2},
+			},
+			expectedWarnings: []string{
+				"date methods of TXN-DATE are not generated, as it is a decimal held in minor units by int64",
+			},
+		},
+		"OccursField_Warned": {
+			record:           &parse.Record{Identifier: "TXN-DATE", Pic: numeric(8), OccursCount: 3},
+			expectedWarnings: []string{"date methods of TXN-DATE are not generated, as it is a group or a table"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var warnings []string
+			g := newGoGenerator(defaultTypeMapping(), WithDecimalRules(DefaultDecimalRules()),
+				WithDateFields([]DateField{mustDateField(t, "*-DATE", DateYYYYMMDD), mustDateField(t, "*-TS", DateDB2Timestamp)}),
+				WithWarningHandler(func(warning string) { warnings = append(warnings, warning) }))
+
+			records := []*parse.Record{tt.record}
+			g.qualifyNames([]*parse.Record{{Identifier: "RECORD", Children: records}}, "")
+			fields, err := g.buildFieldsData(records, "RECORD")
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, g.buildDates("Record", records, fields))
+			assert.Equal(t, tt.expectedWarnings, warnings)
+		})
+	}
+}
+
+func mustDateField(t *testing.T, pattern string, format DateFormat) DateField {
+	t.Helper()
+	dateField, err := NewDateField(pattern, format)
+	require.NoError(t, err)
+	return dateField
+}
@@ -0,0 +1,45 @@
+package generate
+
+import (
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+// DecimalRule maps the decimals with at most a precision and a scale to a Go type, which takes precedence
+// over the mapping of the decimal PIC type. A limit of 0 does not limit the precision or the scale. The Go
+// type may be qualified by the import path of its package, e.g. github.com/shopspring/decimal.Decimal.
+//
+// A decimal mapped to an integer type holds the value in minor units, e.g. cents for a scale of 2, as
+// given by the fracdigits of its pic tag.
+type DecimalRule struct {
+	MaxPrecision int
+	MaxScale     int
+	GoType       string
+}
+
+// DefaultDecimalRules returns the decimal rules of Go structs, which apply unless they are replaced or the
+// decimal type is overridden. A decimal with at most
+// 18 digits and a scale of at most 4 is held in minor units by an int64, which it cannot overflow, and larger
+// decimals are mapped by the decimal PIC type, which is an arbitrary-precision decimal by default.
+func DefaultDecimalRules() []DecimalRule {
+	return []DecimalRule{{MaxPrecision: 18, MaxScale: 4, GoType: "int64"}}
+}
+
+func (r DecimalRule) matches(pic parse.Picture) bool {
+	return (r.MaxPrecision == 0 || pic.Precision() <= r.MaxPrecision) && (r.MaxScale == 0 || pic.Scale() <= r.MaxScale)
+}
+
+// picTypeMappingFor returns the PIC type mappings that apply to a record, which map a decimal to the Go
+// type of the first decimal rule that matches it.
+func (g *goGenerator) picTypeMappingFor(rec *parse.Record) map[parse.PicType]string {
+	// Without its digits, the pic tag of a decimal has no fracdigits to hold it in minor units by.
+	if rec.Pic.PicType != parse.Decimal || len(rec.Children) > 0 || rec.Pic.Precision() == 0 {
+		return g.picTypeMapping
+	}
+
+	for _, rule := range g.decimalRules {
+		if rule.matches(rec.Pic) {
+			return map[parse.PicType]string{parse.Decimal: rule.GoType}
+		}
+	}
+	return g.picTypeMapping
+}
@@ -0,0 +1,120 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+func Test_varType_DecimalRules(t *testing.T) {
+	rules := []DecimalRule{
+		{MaxPrecision: 18, MaxScale: 4, GoType: "int64"},
+		{MaxPrecision: 38, GoType: "github.com/shopspring/decimal.Decimal"},
+		{GoType: "*math/big.Rat"},
+	}
+
+	tests := map[string]struct {
+		rules           []DecimalRule
+		rec             *parse.Record
+		expected        string
+		expectedImports []string
+	}{
+		"NoRules_MappedByPicType": {
+			rec:             &parse.Record{Pic: parse.Picture{PicType: parse.Decimal, IntegerDigits: 5, FractionDigits: 2}},
+			expected:        "decimal.Decimal",
+			expectedImports: []string{"github.com/anzx/fabric-go-pic/pkg/decimal"},
+		},
+		"SmallDecimal_MinorUnits": {
+			rules:    rules,
+			rec:      &parse.Record{Pic: parse.Picture{PicType: parse.Decimal, IntegerDigits: 13, FractionDigits: 2}},
+			expected: "int64",
+		},
+		"LargeScale_FirstMatchingRule": {
+			rules:           rules,
+			rec:             &parse.Record{Pic: parse.Picture{PicType: parse.Decimal, IntegerDigits: 5, FractionDigits: 6}},
+			expected:        "decimal.Decimal",
+			expectedImports: []string{"github.com/shopspring/decimal"},
+		},
+		"ScalingPositions_CountTowardsPrecision": {
+			rules:           rules,
+			rec:             &parse.Record{Pic: parse.Picture{PicType: parse.Decimal, IntegerDigits: 17, FractionDigits: 2, ScaleFactor: -2}},
+			expected:        "decimal.Decimal",
+			expectedImports: []string{"github.com/shopspring/decimal"},
+		},
+		"HugeDecimal_CatchAllRule": {
+			rules:           rules,
+			rec:             &parse.Record{Pic: parse.Picture{PicType: parse.Decimal, IntegerDigits: 31, FractionDigits: 9}},
+			expected:        "*big.Rat",
+			expectedImports: []string{"math/big"},
+		},
+		"Occurs_ArrayOfRuleType": {
+			rules:    rules,
+			rec:      &parse.Record{Pic: parse.Picture{PicType: parse.Decimal, IntegerDigits: 5, FractionDigits: 2}, OccursCount: 3},
+			expected: "[3]int64",
+		},
+		"NotDecimal_MappedByPicType": {
+			rules:    rules,
+			rec:      &parse.Record{Pic: parse.Picture{PicType: parse.Signed, IntegerDigits: 5}},
+			expected: "int",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newGoGenerator(defaultTypeMapping(), WithDecimalRules(tt.rules))
+			assert.Equal(t, tt.expected, g.varType(tt.rec, ""))
+			assert.Equal(t, tt.expectedImports, g.sortedImports())
+		})
+	}
+}
+
+func Test_newGoStructsGenerator_DefaultDecimalRules(t *testing.T) {
+	decimal := func(integerDigits, fractionDigits int) *parse.Record {
+		return &parse.Record{Pic: parse.Picture{PicType: parse.Decimal, IntegerDigits: integerDigits, FractionDigits: fractionDigits}}
+	}
+
+	tests := map[string]struct {
+		typeOverrides map[parse.PicType]string
+		opts          []Option
+		rec           *parse.Record
+		expected      string
+	}{
+		"LargestPrecisionAndScale_MinorUnits": {
+			rec:      decimal(14, 4),
+			expected: "int64",
+		},
+		"PrecisionOverLimit_MappedByPicType": {
+			rec:      decimal(15, 4),
+			expected: "decimal.Decimal",
+		},
+		"ScaleOverLimit_MappedByPicType": {
+			rec:      decimal(5, 5),
+			expected: "decimal.Decimal",
+		},
+		"DecimalTypeOverridden_MappedByOverride": {
+			typeOverrides: map[parse.PicType]string{parse.Decimal: "float64"},
+			rec:           decimal(5, 2),
+			expected:      "float64",
+		},
+		"DecimalTypeOverriddenWithRules_MappedByRules": {
+			typeOverrides: map[parse.PicType]string{parse.Decimal: "float64"},
+			opts:          []Option{WithDecimalRules([]DecimalRule{{MaxScale: 2, GoType: "int32"}})},
+			rec:           decimal(5, 2),
+			expected:      "int32",
+		},
+		"RulesReplaceDefaultRules": {
+			opts:     []Option{WithDecimalRules(nil)},
+			rec:      decimal(5, 2),
+			expected: "decimal.Decimal",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newGoStructsGenerator(tt.typeOverrides, tt.opts...)
+			assert.Equal(t, tt.expected, g.varType(tt.rec, ""))
+		})
+	}
+}
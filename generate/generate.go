@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"fmt"
 	"slices"
+	"strings"
 	"text/template"
 
 	"github.com/yasv98/copybooktogo/util/generic"
@@ -20,47 +21,300 @@ const goStructsGenTemplate = `
 // This file is generated by copybooktogo. DO NOT EDIT.
 
 package {{.Package}}
-
+{{ if .Imports }}
 import (
-    "github.com/anzx/fabric-go-pic/pkg/decimal"
+{{- range .Imports }}
+    "{{ . }}"
+{{- end }}
 )
-
+{{ end }}
 {{ range .Structs }}
 // {{ .StructVarName }} contains a representation of {{ .Identifier }}
 type {{ .StructVarName }} struct {
+    {{- $variants := .Variants }}
     {{- range .Fields }}
-    {{ .FieldVarName }} {{ .VarType }} ` + "`pic:\"{{ .PicTag }}\"`" + ` // start:{{ .PicGlobalStart }} end:{{ .PicGlobalEnd }}{{if .RedefinesVarName}} REDEFINES {{ .RedefinesVarName }}{{end}}
+    {{- if .Variants }}
+    {{ .FieldVarName }} {{ .Variants.TypeName }} ` + "`pic:\"{{ .Variants.PicTag }}\"{{ range .Tags }} {{ .Key }}:\"{{ .Value }}\"{{ end }}`" + ` // start:{{ .PicGlobalStart }} end:{{ .Variants.PicGlobalEnd }}
+    {{- else if not (and $variants .RedefinesVarName) }}
+    {{ .FieldVarName }} {{ .VarType }} ` + "`pic:\"{{ .PicTag }}\"{{ range .Tags }} {{ .Key }}:\"{{ .Value }}\"{{ end }}`" + ` // start:{{ .PicGlobalStart }} end:{{ .PicGlobalEnd }}{{if .RedefinesVarName}} REDEFINES {{ .RedefinesVarName }}{{end}}
     {{- end }}
+    {{- end }}
+}
+{{- $structVarName := .StructVarName }}
+{{- range .Accessors }}
+
+// {{ .Name }} returns the global byte offsets of an element of {{ .FieldVarName }} from its zero-based
+// indexes, as the zero-based start and the exclusive end of the element.
+func ({{ $structVarName }}) {{ .Name }}({{ .Params }} int) (start, end int) {
+	start = {{ .Offset }}
+	return start, start + {{ .Size }}
+}
+{{- end }}
+{{- range .Searches }}
+
+// {{ .Name }} returns the index of the element of {{ .FieldVarName }} with the given keys and whether it
+// was found. Like SEARCH ALL, it is a binary search that relies on the elements being in key order.
+func ({{ .Receiver }} *{{ $structVarName }}) {{ .Name }}({{ .Params }}) (int, bool) {
+	return sort.Find(len({{ .Receiver }}.{{ .FieldVarName }}), func(i int) int {
+		return {{ .Compare }}
+	})
+}
+{{- end }}
+{{- range .Dates }}
+
+// {{ .Getter }} returns {{ .FieldVarName }}, which holds a {{ .Format }} date, as a time.Time in UTC. It
+// returns an error if {{ .FieldVarName }} is not a valid date.
+func ({{ .Receiver }} *{{ $structVarName }}) {{ .Getter }}() (time.Time, error) {
+	{{- if .Numeric }}
+	value := fmt.Sprintf("%0{{ .Width }}d", {{ .Receiver }}.{{ .FieldVarName }})
+	{{- else }}
+	value := {{ .Receiver }}.{{ .FieldVarName }}
+	{{- end }}
+	{{- if .CenturyDigit }}
+	if len(value) != {{ .Width }} || value[0] < '0' || value[0] > '9' {
+		return time.Time{}, fmt.Errorf("{{ .FieldVarName }} %q is not a valid {{ .Format }} date", value)
+	}
+	// The century digit is the number of centuries since 1900.
+	date, err := time.Parse("{{ .Layout }}", fmt.Sprint(19+int(value[0]-'0'), value[1:]))
+	{{- else }}
+	date, err := time.Parse("{{ .Layout }}", value)
+	{{- end }}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("{{ .FieldVarName }} %q is not a valid {{ .Format }} date: %w", value, err)
+	}
+	return date, nil
+}
+
+// {{ .Setter }} sets {{ .FieldVarName }} to a time.Time as a {{ .Format }} date. It returns an error if
+// the year of the time cannot be held by the format.
+func ({{ .Receiver }} *{{ $structVarName }}) {{ .Setter }}(date time.Time) error {
+	if date.Year() < {{ .MinYear }} || date.Year() > {{ .MaxYear }} {
+		return fmt.Errorf("year %d of {{ .FieldVarName }} cannot be held by a {{ .Format }} date", date.Year())
+	}
+	{{- if .CenturyDigit }}
+	value := fmt.Sprint(date.Year()/100-19, date.Format("{{ .Layout }}")[2:])
+	{{- else }}
+	value := date.Format("{{ .Layout }}")
+	{{- end }}
+	{{- if .Numeric }}
+	// The value only has digits, so it is always a number.
+	number, _ := strconv.Atoi(value)
+	{{ .Receiver }}.{{ .FieldVarName }} = {{ .GoType }}(number)
+	{{- else }}
+	{{ .Receiver }}.{{ .FieldVarName }} = value
+	{{- end }}
+	return nil
+}
+{{- end }}
+{{- with .Validation }}
+{{- $receiver := .Receiver }}
+
+// Validate checks the fields of {{ $structVarName }} against the constraints of their PIC clauses and
+// condition names, and returns all the violations with the paths and positions of their fields.
+func ({{ $receiver }} *{{ $structVarName }}) Validate() error {
+	return errors.Join({{ $receiver }}.validate("", 0)...)
+}
+
+// validate returns the violations of the fields of {{ $structVarName }}, whose paths are prefixed by path
+// and whose positions are shifted by shift for an element of a table.
+func ({{ $receiver }} *{{ $structVarName }}) validate(path string, shift int) []error {
+	var errs []error
+	{{- range .Fields }}
+	{{- $field := . }}
+	{{- if and .Group .Occurs }}
+	for index := range {{ $receiver }}.{{ .FieldVarName }} {
+		errs = append(errs, {{ $receiver }}.{{ .FieldVarName }}[index].validate(fmt.Sprintf("%s{{ .FieldVarName }}[%d].", path, index), shift+index*{{ .ElementSize }})...)
+	}
+	{{- else if .Group }}
+	errs = append(errs, {{ $receiver }}.{{ .FieldVarName }}.validate(path+"{{ .FieldVarName }}.", shift)...)
+	{{- else if .Occurs }}
+	for index := range {{ $receiver }}.{{ .FieldVarName }} {
+		{{- range .Checks }}
+		if value := {{ .Value }}; {{ .Violated }} {
+			errs = append(errs, fmt.Errorf("%s{{ $field.FieldVarName }}[%d] (start:%d end:%d): {{ .Message }}", path, index,
+				shift+{{ $field.Start }}+index*{{ $field.ElementSize }}, shift+{{ $field.End }}+index*{{ $field.ElementSize }}, value))
+		}
+		{{- end }}
+	}
+	{{- else }}
+	{{- range .Checks }}
+	if value := {{ .Value }}; {{ .Violated }} {
+		errs = append(errs, fmt.Errorf("%s{{ $field.FieldVarName }} (start:%d end:%d): {{ .Message }}", path, shift+{{ $field.Start }}, shift+{{ $field.End }}, value))
+	}
+	{{- end }}
+	{{- end }}
+	{{- end }}
+	return errs
+}
+{{- end }}
+{{- range .Variants }}
+{{- $variants := . }}
+{{- with .Selector }}
+{{- $selector := . }}
+
+// {{ .Name }} decodes the variant of {{ $variants.FieldVarName }} that is selected by the condition names of
+// {{ .Discriminator }}. It returns an error if none of them is true.
+func ({{ .Receiver }} *{{ $structVarName }}) {{ .Name }}(codec {{ $.Codec }}) (any, error) {
+	switch value := {{ .Value }}; {
+	{{- range .Cases }}
+	case {{ .Matched }}:
+		return {{ $selector.Receiver }}.{{ $variants.FieldVarName }}.As{{ .View }}(codec)
+	{{- end }}
+	default:
+		return nil, fmt.Errorf("{{ .Discriminator }} {{ .Verb }} selects no variant of {{ $variants.FieldVarName }}", value)
+	}
+}
+{{- end }}
+
+// {{ .TypeName }} holds the bytes of {{ .FieldVarName }}, which the fields that redefine it share. Each of
+// them is a variant of the bytes, which is decoded and encoded by a {{ $.Codec }}.
+type {{ .TypeName }} [{{ .Size }}]byte
+{{- range .Views }}
+
+// As{{ .Name }} decodes the bytes as the {{ .Name }} variant.
+func (v *{{ $variants.TypeName }}) As{{ .Name }}(codec {{ $.Codec }}) ({{ .VarType }}, error) {
+	var view struct {
+		{{ .Name }} {{ .VarType }} ` + "`pic:\"{{ .PicTag }}\"`" + `
+	}
+	if err := codec.Unmarshal(v[:{{ .Size }}], &view); err != nil {
+		return view.{{ .Name }}, fmt.Errorf("failed to decode {{ .Name }}: %w", err)
+	}
+	return view.{{ .Name }}, nil
+}
+
+// Set{{ .Name }} encodes a value as the {{ .Name }} variant into the bytes, leaving the bytes after
+// it as they are.
+func (v *{{ $variants.TypeName }}) Set{{ .Name }}(codec {{ $.Codec }}, value {{ .VarType }}) error {
+	view := struct {
+		{{ .Name }} {{ .VarType }} ` + "`pic:\"{{ .PicTag }}\"`" + `
+	}{value}
+	data, err := codec.Marshal(&view)
+	if err != nil {
+		return fmt.Errorf("failed to encode {{ .Name }}: %w", err)
+	}
+	if len(data) != {{ .Size }} {
+		return fmt.Errorf("{{ .Name }} is encoded in %d bytes instead of {{ .Size }}", len(data))
+	}
+	copy(v[:], data)
+	return nil
+}
+{{- end }}
+{{- end }}
+{{ end }}
+{{- range .OpaqueTypes }}
+// {{ .Name }} contains an opaque {{ .Usage }} value, which is only meaningful to the program that set it.
+type {{ .Name }} [{{ .Size }}]byte
+{{ end }}
+{{- with .Codec }}
+// {{ . }} decodes and encodes the variants of REDEFINES fields from and to their bytes, by the pic tags of
+// the structs it is given, like the structs of the copybook are decoded and encoded.
+type {{ . }} interface {
+	Unmarshal(data []byte, v any) error
+	Marshal(v any) ([]byte, error)
 }
 {{ end }}
 `
 
-type templateParams struct {
+// TemplateParams is the model that Go struct templates are executed with, including custom templates.
+type TemplateParams struct {
 	Package string
-	Structs []StructData
+	// Imports are the import paths of the qualified types used by the structs.
+	Imports []string
+	// Structs are the structs of the copybook, starting with the struct of the copybook itself and
+	// followed by the structs of its groups, depth first.
+	Structs     []StructData
+	OpaqueTypes []opaqueTypeData
+	// Codec is the name of the interface that decodes and encodes REDEFINES variants, or empty if there
+	// are none.
+	Codec string
+}
+
+// opaqueTypeData represents the Go type of records with an opaque usage, such as POINTER.
+type opaqueTypeData struct {
+	Name  string
+	Usage string
+	Size  int
 }
 
 // StructData represents a Go struct definition.
 type StructData struct {
 	StructVarName string
-	Identifier    string
-	Fields        []FieldData
+	// Identifier is the COBOL identifier of the group, or the copybook name for the copybook struct.
+	Identifier string
+	Fields     []FieldData
+	Accessors  []accessorData
+	Searches   []searchData
+	Dates      []dateData
+	// Validation is the Validate method of the struct, or nil if structs are not validated.
+	Validation *validationData
+	// Variants are the types holding the bytes of the fields that share them through REDEFINES, which
+	// are only built when REDEFINES variants are generated.
+	Variants []variantsData
 }
 
 // FieldData represents a field in a Go struct.
 type FieldData struct {
-	FieldVarName     string
-	VarType          string
+	FieldVarName string
+	VarType      string
+	// RedefinesVarName is the name of the field that the field redefines, if any.
 	RedefinesVarName string
 	PicSize          int
-	PicTag           string
-	PicGlobalStart   int
-	PicGlobalEnd     int
+	// PicTag is the value of the pic struct tag of the field, and Tags are the configured struct tags
+	// that follow it.
+	PicTag string
+	Tags   []tagData
+	// PicGlobalStart and PicGlobalEnd are the one-based positions of the field in the copybook.
+	PicGlobalStart int
+	PicGlobalEnd   int
+
+	// The following describe the source record so that non-Go emitters can walk the same tree.
+	Identifier    string
+	Pic           parse.Picture
+	Usage         parse.Usage
+	OccursCount   int
+	StructVarName string // Name of the nested struct for group fields, empty for elementary fields.
+	// Dimensions of the multi-dimensional table the field is part of, empty for other fields.
+	Dimensions []Dimension
+	// Variants is the type holding the bytes of the field and of the fields that redefine it, which
+	// replaces them in the Go struct. It is nil unless REDEFINES variants are generated.
+	Variants *variantsData
+	// Slack is true for the slack bytes inserted to align synchronized fields, which only matter to the
+	// byte layout of Go structs.
+	Slack bool
+	// Filler is true for FILLER fields, whose Identifier is named after their parent.
+	Filler bool
 }
 
 type goGenerator struct {
 	pos            *positionTracker
 	picTypeMapping map[parse.PicType]string
+	pointerWidth   int
+	naming         Naming
+	tags           []Tag
+	decimalRules   []DecimalRule
+	fieldOverrides []FieldOverride
+	dateFields     []DateField
+	validation     bool
+	// redefinesVariants replaces the fields that share their bytes through REDEFINES by a type holding
+	// the bytes, whose names are variantsTypeNames.
+	redefinesVariants bool
+	variantsTypeNames map[*parse.Record]string
+	// qualifiedNames are the names of the records qualified by the groups they are in.
+	qualifiedNames map[*parse.Record]string
+	// imports are the import paths of the qualified types of the generated records.
+	imports map[string]bool
+	// slackRecords are the records of the slack bytes inserted to align synchronized records.
+	slackRecords map[*parse.Record]bool
+	// fillerRecords are the FILLER records, which are named after their parent.
+	fillerRecords map[*parse.Record]bool
+	// opaqueUsages are the opaque usages of the generated records, which each need a Go type.
+	opaqueUsages map[parse.Usage]bool
+	// tables are the dimensions of the OCCURS groups enclosing the records being built.
+	tables []Dimension
+	// typeNames and fieldNames are the Go names of the records, resolved so that they do not collide.
+	typeNames  map[*parse.Record]string
+	fieldNames map[*parse.Record]string
+	warn       func(warning string)
 }
 
 type positionInfo struct {
@@ -75,20 +329,19 @@ type positionTracker struct {
 }
 
 // ToGoStructsData generates Go struct definitions from a COBOL copybook AST.
-func ToGoStructsData(ast []*parse.Record, copybookName, packageName string, typeOverrides map[parse.PicType]string) ([]byte, error) {
+//
+// Decimals are mapped by DefaultDecimalRules, unless the decimal type is overridden, so that the
+// override maps all decimals, or WithDecimalRules replaces them.
+func ToGoStructsData(ast []*parse.Record, copybookName, packageName string, typeOverrides map[parse.PicType]string, opts ...Option) ([]byte, error) {
 	if len(ast) == 0 {
 		return nil, fmt.Errorf("ast is empty")
 	}
 
-	goGen := goGenerator{
-		pos: newPositionTracker(),
-		// Merge default PIC type mappings with any configured overrides.
-		picTypeMapping: generic.MergeMaps(defaultTypeMapping(), typeOverrides),
-	}
+	goGen := newGoStructsGenerator(typeOverrides, opts...)
 
-	data := templateParams{
-		Package: packageName,
-		Structs: goGen.buildStructData(copybookName, ast),
+	data, err := goGen.buildTemplateParams(ast, copybookName, packageName)
+	if err != nil {
+		return nil, err
 	}
 
 	generatedCode, err := executeTemplate(goStructsGenTemplate, data)
@@ -99,8 +352,56 @@ func ToGoStructsData(ast []*parse.Record, copybookName, packageName string, type
 	return imports.Process("", generatedCode, nil)
 }
 
-func executeTemplate(genTemplate string, data templateParams) ([]byte, error) {
-	t, err := template.New("").Parse(genTemplate)
+// newGoStructsGenerator creates the generator of Go structs, which merges the default PIC type mappings
+// with the overrides and maps decimals by DefaultDecimalRules, unless the decimal type is overridden.
+func newGoStructsGenerator(typeOverrides map[parse.PicType]string, opts ...Option) *goGenerator {
+	if _, ok := typeOverrides[parse.Decimal]; !ok {
+		// The default rules come first, so that WithDecimalRules replaces them.
+		opts = append([]Option{WithDecimalRules(DefaultDecimalRules())}, opts...)
+	}
+	return newGoGenerator(generic.MergeMaps(defaultTypeMapping(), typeOverrides), opts...)
+}
+
+func newGoGenerator(picTypeMapping map[parse.PicType]string, opts ...Option) *goGenerator {
+	g := &goGenerator{
+		pos:            newPositionTracker(),
+		picTypeMapping: picTypeMapping,
+		pointerWidth:   defaultPointerWidth,
+		opaqueUsages:   make(map[parse.Usage]bool),
+		slackRecords:   make(map[*parse.Record]bool),
+		fillerRecords:  make(map[*parse.Record]bool),
+		imports:        make(map[string]bool),
+		qualifiedNames: make(map[*parse.Record]string),
+		warn:           func(string) {},
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	return g
+}
+
+func (g *goGenerator) buildTemplateParams(ast []*parse.Record, copybookName, packageName string) (TemplateParams, error) {
+	structs, err := g.buildCopybookStructData(copybookName, ast)
+	if err != nil {
+		return TemplateParams{}, err
+	}
+
+	data := TemplateParams{
+		Package: packageName,
+		Structs: structs,
+	}
+	data.OpaqueTypes = g.buildOpaqueTypeData()
+	if slices.ContainsFunc(data.Structs, func(s StructData) bool { return len(s.Variants) > 0 }) {
+		data.Codec = codecTypeName(g.naming.typeName(copybookName))
+	}
+	data.Imports = g.sortedImports()
+
+	return data, nil
+}
+
+func executeTemplate(genTemplate string, data any) ([]byte, error) {
+	t, err := template.New("").Funcs(templateFuncs).Parse(genTemplate)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse template: %w", err)
 	}
@@ -112,83 +413,167 @@ func executeTemplate(genTemplate string, data templateParams) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func (g *goGenerator) buildStructData(parentName string, records []*parse.Record) []StructData {
+func (g *goGenerator) buildStructData(structVarName, parentName string, records []*parse.Record) ([]StructData, error) {
+	fields, err := g.buildFieldsData(records, parentName)
+	if err != nil {
+		return nil, err
+	}
+
 	currentStruct := StructData{
-		StructVarName: toGoName(parentName),
+		StructVarName: structVarName,
 		Identifier:    parentName,
-		Fields:        g.buildFieldsData(records, parentName),
+		Fields:        fields,
+	}
+	currentStruct.Accessors = g.buildAccessors(currentStruct.StructVarName, currentStruct.Fields)
+	// The methods of the struct cannot use the fields that are replaced by REDEFINES variants.
+	structRecords, structFields := records, currentStruct.Fields
+	if g.redefinesVariants {
+		currentStruct.Variants = g.buildVariants(structVarName, records, currentStruct.Fields)
+		for i := range currentStruct.Variants {
+			base := slices.IndexFunc(currentStruct.Fields, func(f FieldData) bool {
+				return f.FieldVarName == currentStruct.Variants[i].FieldVarName
+			})
+			currentStruct.Fields[base].Variants = &currentStruct.Variants[i]
+		}
+		structRecords, structFields = withoutVariants(records, currentStruct.Fields)
+	}
+	currentStruct.Searches = g.buildSearches(currentStruct.StructVarName, structRecords)
+	currentStruct.Dates = g.buildDates(currentStruct.StructVarName, structRecords, structFields)
+	if g.validation {
+		if name, ok := g.validationClash(records); ok {
+			g.warn(fmt.Sprintf("Validate method of %s is not generated, as %s is the name of one of its fields",
+				currentStruct.StructVarName, name))
+		} else {
+			currentStruct.Validation = g.buildValidation(currentStruct.StructVarName, structRecords, structFields)
+		}
 	}
 
 	// Recursively process nested struct fields.
 	var nestedStructs []StructData
-	for _, field := range records {
+	for i, field := range records {
 		if len(field.Children) > 0 {
 			// A field's children will start from the same global position as the parent field.
-			_, g.pos.globalPos = g.pos.getStoredPos(field.Identifier)
-			nestedStructs = slices.Concat(nestedStructs, g.buildStructData(field.Identifier, field.Children))
+			g.pos.globalPos = currentStruct.Fields[i].PicGlobalStart
+			if err := g.enterTable(field); err != nil {
+				return nil, err
+			}
+			fieldStructs, err := g.buildStructData(g.typeName(field), field.Identifier, field.Children)
+			if err != nil {
+				return nil, err
+			}
+			nestedStructs = slices.Concat(nestedStructs, fieldStructs)
+			g.leaveTable(field)
 		}
 	}
 
-	return slices.Concat([]StructData{currentStruct}, nestedStructs)
+	return slices.Concat([]StructData{currentStruct}, nestedStructs), nil
 }
 
-func (g *goGenerator) buildFieldsData(records []*parse.Record, parentName string) []FieldData {
+func (g *goGenerator) buildFieldsData(records []*parse.Record, parentName string) ([]FieldData, error) {
 	fields := make([]FieldData, 0, len(records))
+	varNames := make(map[string]string, len(records))
 	fillerCount := 0
 	g.pos.localPos = 1
+	// The fields that share their bytes through REDEFINES take up the bytes of the largest of them.
+	localEnd, globalEnd := g.pos.localPos, g.pos.globalPos
 
 	for _, rec := range records {
-		fillerCount = handleFillerName(rec, parentName, fillerCount)
+		fillerCount = g.handleFillerName(rec, parentName, fillerCount)
+		if rec.Redefines == "" {
+			g.pos.localPos, g.pos.globalPos = localEnd, globalEnd
+		}
 
 		// Build and store field data
-		fieldData := g.buildFieldData(rec)
-		fieldData = handleRedefines(rec, fieldData, g.pos)
+		fieldData, err := g.buildFieldData(rec)
+		if err != nil {
+			return nil, err
+		}
+		if fieldData, err = g.handleRedefines(rec, fieldData, parentName); err != nil {
+			return nil, err
+		}
+		if rec.Redefines != "" {
+			fieldData.RedefinesVarName = varNames[identifierKey(rec.Redefines)]
+		}
+		varNames[identifierKey(rec.Identifier)] = fieldData.FieldVarName
 		fields = append(fields, fieldData)
 
 		// Update position tracking
 		g.pos.storeAndAdvancePos(rec.Identifier, fieldData.PicSize)
+		localEnd, globalEnd = max(localEnd, g.pos.localPos), max(globalEnd, g.pos.globalPos)
 	}
 
-	return fields
+	return fields, nil
 }
 
-func (g *goGenerator) buildFieldData(rec *parse.Record) FieldData {
-	varName := toGoName(rec.Identifier)
-	size := calculateSize(rec)
+func (g *goGenerator) buildFieldData(rec *parse.Record) (FieldData, error) {
+	varName := g.fieldName(rec)
+	typeName := g.typeName(rec)
+	size, err := g.calculateSize(rec)
+	if err != nil {
+		return FieldData{}, err
+	}
+	if rec.Usage.IsOpaque() && len(rec.Children) == 0 {
+		g.opaqueUsages[rec.Usage] = true
+	}
 
-	return FieldData{
+	dimensions := g.tableDimensions(rec, size)
+	fieldData := FieldData{
 		FieldVarName:   varName,
-		VarType:        getVarType(rec, varName, g.picTypeMapping),
+		VarType:        g.varType(rec, typeName),
 		PicSize:        size,
-		PicTag:         getPicTag(rec, size, g.pos.localPos),
+		PicTag:         getPicTag(rec, size, g.pos.localPos, dimensions),
+		Tags:           buildTags(g.tags, rec, varName),
 		PicGlobalStart: g.pos.globalPos,
 		PicGlobalEnd:   g.pos.globalPos + size - 1,
+		Identifier:     rec.Identifier,
+		Pic:            rec.Pic,
+		Usage:          rec.Usage,
+		OccursCount:    rec.OccursCount,
+		Dimensions:     dimensions,
+		Slack:          g.slackRecords[rec],
+		Filler:         g.fillerRecords[rec],
 	}
+	if len(rec.Children) > 0 {
+		fieldData.StructVarName = typeName
+	}
+
+	return fieldData, nil
 }
 
-func handleRedefines(rec *parse.Record, fieldData FieldData, pos *positionTracker) FieldData {
+func (g *goGenerator) handleRedefines(rec *parse.Record, fieldData FieldData, parentName string) (FieldData, error) {
 	if rec.Redefines != "" {
 		// If a field redefines another field, its local and global
 		// start position will be the same as the redefined field.
-		pos.localPos, pos.globalPos = pos.getStoredPos(rec.Redefines)
+		localPos, globalPos, ok := g.pos.getStoredPos(rec.Redefines)
+		if !ok {
+			return fieldData, unresolvedRedefinesError(rec, parentName)
+		}
+		g.pos.localPos, g.pos.globalPos = localPos, globalPos
 
-		fieldData.RedefinesVarName = toGoName(rec.Redefines)
-		fieldData.PicTag = getPicTag(rec, fieldData.PicSize, pos.localPos)
-		fieldData.PicGlobalStart = pos.globalPos
-		fieldData.PicGlobalEnd = pos.globalPos + fieldData.PicSize - 1
+		fieldData.PicTag = getPicTag(rec, fieldData.PicSize, g.pos.localPos, fieldData.Dimensions)
+		fieldData.PicGlobalStart = g.pos.globalPos
+		fieldData.PicGlobalEnd = g.pos.globalPos + fieldData.PicSize - 1
 	}
 
-	return fieldData
+	return fieldData, nil
 }
 
-func handleFillerName(rec *parse.Record, parentName string, fillerCount int) int {
+// unresolvedRedefinesError returns the error for a REDEFINES clause whose record is not found before it
+// in the group it is in, which is named by parentName.
+func unresolvedRedefinesError(rec *parse.Record, parentName string) error {
+	return fmt.Errorf("REDEFINES %s of %s on line %d is not found in group %s", rec.Redefines, rec.Identifier,
+		rec.Line, parentName)
+}
+
+func (g *goGenerator) handleFillerName(rec *parse.Record, parentName string, fillerCount int) int {
 	// FILLER is a keyword that can be used multiple times at a group and individual
 	// level in COBOL. This logic ensure there is no clashes with struct and field
 	// names when generating the Go code.
 	const fillerKeyWord = "FILLER"
-	if rec.Identifier == fillerKeyWord {
+	if strings.EqualFold(rec.Identifier, fillerKeyWord) {
 		fillerCount++
 		rec.Identifier = fmt.Sprint(parentName, "-", fillerKeyWord, fillerCount)
+		g.fillerRecords[rec] = true
 	}
 
 	return fillerCount
@@ -198,6 +583,45 @@ func toGoName(s string) string {
 	return snaker.SnakeToCamelIdentifier(s)
 }
 
+// identifierKey returns the key that a record is looked up by. COBOL identifiers are case-insensitive,
+// so a REDEFINES clause can refer to a record in a different case than it was declared in.
+func identifierKey(identifier string) string {
+	return strings.ToUpper(identifier)
+}
+
+// toSnakeIdentifier converts a COBOL identifier to a lower snake case identifier matching
+// [a-z_][a-z0-9_]*, which is legal in most schema languages.
+func toSnakeIdentifier(identifier string) string {
+	name := strings.Map(func(r rune) rune {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, strings.ToLower(identifier))
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
+
+// goFloatTypes maps the floating-point usages, which have no PIC clause, to the Go type that holds them.
+var goFloatTypes = map[parse.Usage]string{
+	parse.Float:  "float32",
+	parse.Double: "float64",
+}
+
+// varType returns the Go type of the field of a record, and records the import it needs.
+func (g *goGenerator) varType(rec *parse.Record, typeName string) string {
+	if goType, ok := g.fieldOverride(rec); ok {
+		if rec.OccursCount > 1 {
+			goType = fmt.Sprint("[", rec.OccursCount, "]", goType)
+		}
+		return g.qualifyType(goType)
+	}
+
+	return g.qualifyType(getVarType(rec, typeName, g.picTypeMappingFor(rec)))
+}
+
 func getVarType(rec *parse.Record, varName string, picTypeMappings map[parse.PicType]string) string {
 	switch {
 	case len(rec.Children) == 0:
@@ -206,6 +630,12 @@ func getVarType(rec *parse.Record, varName string, picTypeMappings map[parse.Pic
 			// Default to string if no mapping is found.
 			goType = "string"
 		}
+		if rec.Usage.IsOpaque() {
+			goType = opaqueTypeName(rec.Usage)
+		}
+		if floatType, ok := goFloatTypes[rec.Usage]; ok {
+			goType = floatType
+		}
 
 		if rec.OccursCount > 1 {
 			return fmt.Sprint("[", rec.OccursCount, "]", goType)
@@ -218,14 +648,24 @@ func getVarType(rec *parse.Record, varName string, picTypeMappings map[parse.Pic
 	}
 }
 
-func getPicTag(rec *parse.Record, fieldSize, localStartPos int) string {
+func getPicTag(rec *parse.Record, fieldSize, localStartPos int, dimensions []Dimension) string {
 	picTag := fmt.Sprint(localStartPos, ",", localStartPos+fieldSize-1)
 	if rec.OccursCount > 1 {
 		picTag += fmt.Sprint(",", rec.OccursCount)
 	}
+	picTag += getDimensionsTag(dimensions)
 
 	if len(rec.Children) == 0 {
-		picTag += fmt.Sprint(",clause=", rec.Pic.PicString)
+		if rec.Usage != parse.Display {
+			picTag += fmt.Sprint(",usage=", rec.Usage)
+		}
+		// Records with a usage such as POINTER or COMP-1 have no PIC clause.
+		if rec.Pic != (parse.Picture{}) {
+			picTag += getPicMetadataTag(rec.Pic)
+			picTag += getPresentationTag(rec)
+			// PIC symbols are case-insensitive, and the clause is normalised to upper case for decoders.
+			picTag += fmt.Sprint(",clause=", strings.ToUpper(rec.Pic.PicString))
+		}
 	} else {
 		// To account for group fields with occurs, we need to calculate the size of the group for
 		// one occurrence.
@@ -237,46 +677,159 @@ func getPicTag(rec *parse.Record, fieldSize, localStartPos int) string {
 	return picTag
 }
 
-func calculateSize(rec *parse.Record) int {
+// picEncodings are the encodings of the PIC types that do not hold single-byte characters, which a
+// decoder needs to convert their values to UTF-8 Go strings.
+var picEncodings = map[parse.PicType]string{
+	parse.National: "utf16be",
+	parse.DBCS:     "dbcs",
+}
+
+// getPicMetadataTag returns the numeric details and character encoding of a PIC clause as tag options.
+// Options with a zero value are omitted. They are placed before the clause option, as edited PIC
+// clauses may contain commas.
+func getPicMetadataTag(pic parse.Picture) string {
+	var metadataTag string
+	if encoding, ok := picEncodings[pic.PicType]; ok {
+		metadataTag += ",enc=" + encoding
+	}
+	if pic.IntegerDigits > 0 {
+		metadataTag += fmt.Sprint(",intdigits=", pic.IntegerDigits)
+	}
+	if pic.FractionDigits > 0 {
+		metadataTag += fmt.Sprint(",fracdigits=", pic.FractionDigits)
+	}
+	if pic.ScaleFactor != 0 {
+		metadataTag += fmt.Sprint(",scalefactor=", pic.ScaleFactor)
+	}
+	if pic.Signed {
+		metadataTag += ",signed"
+	}
+	if pic.Edited {
+		metadataTag += ",edited"
+	}
+
+	return metadataTag
+}
+
+// getPresentationTag returns the options for the clauses that change how a value is written into its
+// field, rather than what the value is.
+func getPresentationTag(rec *parse.Record) string {
+	var presentationTag string
+	if rec.Justified {
+		presentationTag += ",justified"
+	}
+	if rec.BlankWhenZero {
+		presentationTag += ",blankwhenzero"
+	}
+
+	return presentationTag
+}
+
+func (g *goGenerator) calculateSize(rec *parse.Record) (int, error) {
 	if len(rec.Children) == 0 {
-		return rec.Pic.PicCount * max(1, rec.OccursCount)
+		return g.storageSize(rec) * max(1, rec.OccursCount), nil
 	}
 
-	return calculateGroupSize(rec)
+	return g.calculateGroupSize(rec)
 }
 
-func calculateGroupSize(rec *parse.Record) int {
+// storageSize returns the number of bytes that one occurrence of an elementary record takes up,
+// which depends on its usage.
+func (g *goGenerator) storageSize(rec *parse.Record) int {
+	digits := rec.Pic.IntegerDigits + rec.Pic.FractionDigits
+	switch rec.Usage {
+	case parse.Binary, parse.NativeBinary:
+		return binarySize(digits)
+	case parse.Float:
+		return fullword
+	case parse.Double:
+		return doubleword
+	case parse.PackedDecimal:
+		// Two digits are packed into each byte, with the sign in the last half byte.
+		return digits/2 + 1
+	case parse.Index, parse.Pointer, parse.ProcedurePointer, parse.FunctionPointer:
+		return g.opaqueSize(rec.Usage)
+	default:
+		return rec.Pic.PicCount
+	}
+}
+
+// binarySize returns the size of a binary number: a halfword for up to 4 digits, a fullword for up to
+// 9 digits and a doubleword for up to 18 digits.
+func binarySize(digits int) int {
+	switch {
+	case digits <= 4:
+		return halfword
+	case digits <= 9:
+		return fullword
+	default:
+		return doubleword
+	}
+}
+
+// opaqueSize returns the width of a record with an opaque usage, which depends on the addressing
+// mode of the program. A PROCEDURE-POINTER holds both the entry point and the environment of a program.
+func (g *goGenerator) opaqueSize(usage parse.Usage) int {
+	if usage == parse.ProcedurePointer {
+		return 2 * g.pointerWidth
+	}
+
+	return g.pointerWidth
+}
+
+func (g *goGenerator) buildOpaqueTypeData() []opaqueTypeData {
+	var opaqueTypes []opaqueTypeData
+	for _, usage := range parse.UsageValues() {
+		if g.opaqueUsages[usage] {
+			opaqueTypes = append(opaqueTypes, opaqueTypeData{
+				Name:  opaqueTypeName(usage),
+				Usage: strings.ToUpper(usage.String()),
+				Size:  g.opaqueSize(usage),
+			})
+		}
+	}
+
+	return opaqueTypes
+}
+
+func opaqueTypeName(usage parse.Usage) string {
+	return toGoName(usage.String())
+}
+
+func (g *goGenerator) calculateGroupSize(rec *parse.Record) (int, error) {
 	size := 0
 	sizeStore := make(map[string]int)
 
 	for _, child := range rec.Children {
-		childSize := calculateSize(child)
+		childSize, err := g.calculateSize(child)
+		if err != nil {
+			return 0, err
+		}
 
 		// Store the size of the child for redefines handling.
-		sizeStore[child.Identifier] = childSize
+		sizeStore[identifierKey(child.Identifier)] = childSize
 
 		if child.Redefines == "" {
 			size += childSize
 		} else {
-			// A field that redefines another can be of a different size.
-			// To account for this, we subtract the size of the redefined field
-			// and add the size of the redefining field as it takes precedent.
-			redefinedChildSize, ok := sizeStore[child.Redefines]
+			// A field that redefines another can be of a different size, and the
+			// fields sharing their bytes take up the bytes of the largest of them.
+			// A redefined field must be at the same level before the redefining field.
+			redefinedChildSize, ok := sizeStore[identifierKey(child.Redefines)]
 			if !ok {
-				// This should never happen as a redefined field should always be
-				// processed at the same level before the redefining field.
-				panic(fmt.Sprint("redefined field ", child.Redefines, " not found"))
+				return 0, unresolvedRedefinesError(child, rec.Identifier)
 			}
-			size -= redefinedChildSize
-			size += childSize
+			if childSize > redefinedChildSize {
+				size += childSize - redefinedChildSize
 
-			// A redefined field size will be updated to the new field size for
-			// future references.
-			sizeStore[child.Redefines] = childSize
+				// A redefined field size will be updated to the new field size for
+				// future references.
+				sizeStore[identifierKey(child.Redefines)] = childSize
+			}
 		}
 	}
 
-	return size * max(1, rec.OccursCount)
+	return size * max(1, rec.OccursCount), nil
 }
 
 func newPositionTracker() *positionTracker {
@@ -284,26 +837,28 @@ func newPositionTracker() *positionTracker {
 }
 
 func (p *positionTracker) storeAndAdvancePos(identifier string, size int) {
-	p.recordStore[identifier] = positionInfo{localStart: p.localPos, globalStart: p.globalPos}
+	p.recordStore[identifierKey(identifier)] = positionInfo{localStart: p.localPos, globalStart: p.globalPos}
 	p.localPos += size
 	p.globalPos += size
 }
 
-func (p *positionTracker) getStoredPos(identifier string) (int, int) {
-	pos, ok := p.recordStore[identifier]
-	if !ok {
-		// This should never happen as a record should never be accessed before being stored.
-		panic(fmt.Sprint("position for ", identifier, " not found"))
-	}
-	return pos.localStart, pos.globalStart
+// getStoredPos returns the local and global start positions of a record, and whether the record has
+// been stored.
+func (p *positionTracker) getStoredPos(identifier string) (int, int, bool) {
+	pos, ok := p.recordStore[identifierKey(identifier)]
+	return pos.localStart, pos.globalStart, ok
 }
 
 func defaultTypeMapping() map[parse.PicType]string {
 	return map[parse.PicType]string{
-		parse.Unsigned: "uint",
-		parse.Signed:   "int",
-		parse.Decimal:  "decimal.Decimal",
-		parse.Alpha:    "string",
-		parse.Unknown:  "string",
+		parse.Unsigned:      "uint",
+		parse.Signed:        "int",
+		parse.Decimal:       "github.com/anzx/fabric-go-pic/pkg/decimal.Decimal",
+		parse.Alpha:         "string",
+		parse.NumericEdited: "string",
+		parse.AlphaEdited:   "string",
+		parse.National:      "string",
+		parse.DBCS:          "string",
+		parse.Unknown:       "string",
 	}
 }
@@ -12,6 +12,7 @@ func Test_GenerateGoStructsData(t *testing.T) {
 	tests := map[string]struct {
 		input         []*parse.Record
 		typeOverrides map[parse.PicType]string
+		opts          []Option
 		expected      []byte
 		assertError   assert.ErrorAssertionFunc
 	}{
@@ -38,7 +39,7 @@ func Test_GenerateGoStructsData(t *testing.T) {
 										{
 											Level:      7,
 											Identifier: "RECORD-4",
-											Pic:        parse.Picture{PicString: "S9(07)", PicType: parse.Signed, PicCount: 8},
+											Pic:        parse.Picture{PicString: "S9(07)", PicType: parse.Signed, PicCount: 8, IntegerDigits: 7, Signed: true},
 										},
 									},
 								},
@@ -93,7 +94,7 @@ type Record1Filler1 struct {
 
 // Record3 contains a representation of RECORD-3
 type Record3 struct {
-	Record4 int ` + "`pic:\"1,8,clause=S9(07)\"`" + ` // start:2 end:9
+	Record4 int ` + "`pic:\"1,8,intdigits=7,signed,clause=S9(07)\"`" + ` // start:2 end:9
 }
 
 // Record5 contains a representation of RECORD-5
@@ -159,6 +160,955 @@ package main
 type Copybook struct {
 	Record int ` + "`pic:\"1,17,clause=9(17)\"`" + ` // start:1 end:17
 }
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithEditedPic_ReturnsGoStructsWithStringField": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "RECORD",
+					Pic: parse.Picture{
+						PicString:      "ZZ,ZZ9.99-",
+						PicType:        parse.NumericEdited,
+						PicCount:       10,
+						IntegerDigits:  5,
+						FractionDigits: 2,
+						Signed:         true,
+						Edited:         true,
+						EditMask:       "ZZ,ZZ9.99-",
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+package main
+
+// Copybook contains a representation of Copybook
+type Copybook struct {
+	Record string ` + "`pic:\"1,10,intdigits=5,fracdigits=2,signed,edited,clause=ZZ,ZZ9.99-\"`" + ` // start:1 end:10
+}
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithPointers_ReturnsGoStructsWithOpaqueTypes": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "RECORD-1",
+					Children: []*parse.Record{
+						{Level: 5, Identifier: "RECORD-2", Usage: parse.Pointer, OccursCount: 2},
+						{Level: 5, Identifier: "RECORD-3", Usage: parse.ProcedurePointer},
+						{
+							Level:      5,
+							Identifier: "RECORD-4",
+							Pic:        parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2},
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			opts:          []Option{WithPointerWidth(8)},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+package main
+
+// Copybook contains a representation of Copybook
+type Copybook struct {
+	Record1 Record1 ` + "`pic:\"1,34,clause=X(34)\"`" + ` // start:1 end:34
+}
+
+// Record1 contains a representation of RECORD-1
+type Record1 struct {
+	Record2 [2]Pointer       ` + "`pic:\"1,16,2,usage=pointer\"`" + `          // start:1 end:16
+	Record3 ProcedurePointer ` + "`pic:\"17,32,usage=procedure-pointer\"`" + ` // start:17 end:32
+	Record4 string           ` + "`pic:\"33,34,clause=X(02)\"`" + `            // start:33 end:34
+}
+
+// Pointer contains an opaque POINTER value, which is only meaningful to the program that set it.
+type Pointer [8]byte
+
+// ProcedurePointer contains an opaque PROCEDURE-POINTER value, which is only meaningful to the program that set it.
+type ProcedurePointer [16]byte
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithSynchronizedBinary_ReturnsGoStructsWithSlackBytes": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "RECORD-1",
+					Children: []*parse.Record{
+						{
+							Level:      5,
+							Identifier: "RECORD-2",
+							Pic:        parse.Picture{PicString: "X(01)", PicType: parse.Alpha, PicCount: 1},
+						},
+						{
+							Level:        5,
+							Identifier:   "RECORD-3",
+							Pic:          parse.Picture{PicString: "S9(09)", PicType: parse.Signed, PicCount: 10, IntegerDigits: 9, Signed: true},
+							Usage:        parse.Binary,
+							Synchronized: true,
+						},
+						{
+							Level:      5,
+							Identifier: "RECORD-4",
+							Pic:        parse.Picture{PicString: "S9(05)", PicType: parse.Signed, PicCount: 6, IntegerDigits: 5, Signed: true},
+							Usage:      parse.PackedDecimal,
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+package main
+
+// Copybook contains a representation of Copybook
+type Copybook struct {
+	Record1 Record1 ` + "`pic:\"1,11,clause=X(11)\"`" + ` // start:1 end:11
+}
+
+// Record1 contains a representation of RECORD-1
+type Record1 struct {
+	Record2            string ` + "`pic:\"1,1,clause=X(01)\"`" + `                                           // start:1 end:1
+	Record1SlackBytes1 string ` + "`pic:\"2,4,clause=X(03)\"`" + `                                           // start:2 end:4
+	Record3            int    ` + "`pic:\"5,8,usage=binary,intdigits=9,signed,clause=S9(09)\"`" + `          // start:5 end:8
+	Record4            int    ` + "`pic:\"9,11,usage=packed-decimal,intdigits=5,signed,clause=S9(05)\"`" + ` // start:9 end:11
+}
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_MixedCaseCopybook_ReturnsGoStructs": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "cust-rec",
+					Children: []*parse.Record{
+						{
+							Level:      5,
+							Identifier: "filler",
+							Pic:        parse.Picture{PicString: "x(02)", PicType: parse.Alpha, PicCount: 2},
+						},
+						{
+							Level:      5,
+							Identifier: "Cust-Id",
+							Pic:        parse.Picture{PicString: "9(04)", PicType: parse.Unsigned, PicCount: 4, IntegerDigits: 4},
+						},
+						{
+							Level:      5,
+							Identifier: "cust-alt",
+							Redefines:  "CUST-ID",
+							Pic:        parse.Picture{PicString: "x(04)", PicType: parse.Alpha, PicCount: 4},
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+package main
+
+// Copybook contains a representation of Copybook
+type Copybook struct {
+	CustRec CustRec ` + "`pic:\"1,6,clause=X(06)\"`" + ` // start:1 end:6
+}
+
+// CustRec contains a representation of cust-rec
+type CustRec struct {
+	CustRecFiller1 string ` + "`pic:\"1,2,clause=X(02)\"`" + `             // start:1 end:2
+	CustID         uint   ` + "`pic:\"3,6,intdigits=4,clause=9(04)\"`" + ` // start:3 end:6
+	CustAlt        string ` + "`pic:\"3,6,clause=X(04)\"`" + `             // start:3 end:6 REDEFINES CustID
+}
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_MultiDimensionalTable_ReturnsGoStructsWithAccessors": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "RATE-TABLE",
+					Children: []*parse.Record{
+						{
+							Level:      5,
+							Identifier: "HEADER",
+							Pic:        parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2},
+						},
+						{
+							Level:       5,
+							Identifier:  "REGION",
+							OccursCount: 2,
+							Children: []*parse.Record{
+								{
+									Level:      10,
+									Identifier: "REGION-CODE",
+									Pic:        parse.Picture{PicString: "X(01)", PicType: parse.Alpha, PicCount: 1},
+								},
+								{
+									Level:       10,
+									Identifier:  "TERM",
+									OccursCount: 3,
+									Children: []*parse.Record{
+										{
+											Level:       15,
+											Identifier:  "RATE",
+											OccursCount: 4,
+											Pic:         parse.Picture{PicString: "9(03)", PicType: parse.Unsigned, PicCount: 3, IntegerDigits: 3},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+package main
+
+// Copybook contains a representation of Copybook
+type Copybook struct {
+	RateTable RateTable ` + "`pic:\"1,76,clause=X(76)\"`" + ` // start:1 end:76
+}
+
+// RateTable contains a representation of RATE-TABLE
+type RateTable struct {
+	Header string    ` + "`pic:\"1,2,clause=X(02)\"`" + `    // start:1 end:2
+	Region [2]Region ` + "`pic:\"3,76,2,clause=X(37)\"`" + ` // start:3 end:76
+}
+
+// Region contains a representation of REGION
+type Region struct {
+	RegionCode string  ` + "`pic:\"1,1,clause=X(01)\"`" + `                           // start:3 end:3
+	Term       [3]Term ` + "`pic:\"2,37,3,dims=2;3,strides=37;12,clause=X(12)\"`" + ` // start:4 end:39
+}
+
+// TermAt returns the global byte offsets of an element of Term from its zero-based
+// indexes, as the zero-based start and the exclusive end of the element.
+func (Region) TermAt(i, j int) (start, end int) {
+	start = 3 + i*37 + j*12
+	return start, start + 12
+}
+
+// Term contains a representation of TERM
+type Term struct {
+	Rate [4]uint ` + "`pic:\"1,12,4,dims=2;3;4,strides=37;12;3,intdigits=3,clause=9(03)\"`" + ` // start:4 end:15
+}
+
+// RateAt returns the global byte offsets of an element of Rate from its zero-based
+// indexes, as the zero-based start and the exclusive end of the element.
+func (Term) RateAt(i, j, k int) (start, end int) {
+	start = 3 + i*37 + j*12 + k*3
+	return start, start + 3
+}
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_TablesWithKeys_ReturnsGoStructsWithSearches": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "RATE-TABLE",
+					Children: []*parse.Record{
+						{
+							Level:       5,
+							Identifier:  "RATES",
+							OccursCount: 3,
+							Keys:        []parse.Key{{Identifier: "RATE-ID"}, {Identifier: "RATE-DATE", Descending: true}},
+							Indexes:     []string{"RATE-IDX"},
+							Children: []*parse.Record{
+								{
+									Level:      10,
+									Identifier: "RATE-ID",
+									Pic:        parse.Picture{PicString: "X(03)", PicType: parse.Alpha, PicCount: 3},
+								},
+								{
+									Level:      10,
+									Identifier: "RATE-DETAIL",
+									Children: []*parse.Record{
+										{
+											Level:      15,
+											Identifier: "RATE-DATE",
+											Pic:        parse.Picture{PicString: "9(08)", PicType: parse.Unsigned, PicCount: 8, IntegerDigits: 8},
+										},
+									},
+								},
+							},
+						},
+						{
+							Level:       5,
+							Identifier:  "CODES",
+							OccursCount: 2,
+							Keys:        []parse.Key{{Identifier: "CODES"}},
+							Pic:         parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2},
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+package main
+
+import (
+	"cmp"
+	"sort"
+)
+
+// Copybook contains a representation of Copybook
+type Copybook struct {
+	RateTable RateTable ` + "`pic:\"1,37,clause=X(37)\"`" + ` // start:1 end:37
+}
+
+// RateTable contains a representation of RATE-TABLE
+type RateTable struct {
+	Rates [3]Rates  ` + "`pic:\"1,33,3,clause=X(11)\"`" + `  // start:1 end:33
+	Codes [2]string ` + "`pic:\"34,37,2,clause=X(02)\"`" + ` // start:34 end:37
+}
+
+// SearchRates returns the index of the element of Rates with the given keys and whether it
+// was found. Like SEARCH ALL, it is a binary search that relies on the elements being in key order.
+func (r *RateTable) SearchRates(rateID string, rateDate uint) (int, bool) {
+	return sort.Find(len(r.Rates), func(i int) int {
+		return cmp.Or(cmp.Compare(rateID, r.Rates[i].RateID), cmp.Compare(r.Rates[i].RateDetail.RateDate, rateDate))
+	})
+}
+
+// SearchCodes returns the index of the element of Codes with the given keys and whether it
+// was found. Like SEARCH ALL, it is a binary search that relies on the elements being in key order.
+func (r *RateTable) SearchCodes(codes string) (int, bool) {
+	return sort.Find(len(r.Codes), func(i int) int {
+		return cmp.Compare(codes, r.Codes[i])
+	})
+}
+
+// Rates contains a representation of RATES
+type Rates struct {
+	RateID     string     ` + "`pic:\"1,3,clause=X(03)\"`" + `  // start:1 end:3
+	RateDetail RateDetail ` + "`pic:\"4,11,clause=X(08)\"`" + ` // start:4 end:11
+}
+
+// RateDetail contains a representation of RATE-DETAIL
+type RateDetail struct {
+	RateDate uint ` + "`pic:\"1,8,intdigits=8,clause=9(08)\"`" + ` // start:4 end:11
+}
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithNaming_ReturnsGoStructsWithConfiguredNames": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "WS-CUST-REC",
+					Children: []*parse.Record{
+						{
+							Level:      5,
+							Identifier: "WS-CUST-ID",
+							Pic:        parse.Picture{PicString: "9(04)", PicType: parse.Unsigned, PicCount: 4, IntegerDigits: 4},
+						},
+						{
+							Level:      5,
+							Identifier: "WS-CUST-CODE",
+							Redefines:  "WS-CUST-ID",
+							Pic:        parse.Picture{PicString: "X(04)", PicType: parse.Alpha, PicCount: 4},
+						},
+						{
+							Level:      5,
+							Identifier: "WS-CUST-ADDR",
+							Children: []*parse.Record{
+								{
+									Level:      10,
+									Identifier: "WS-CUST-ADDR-LINE-1",
+									Pic:        parse.Picture{PicString: "X(10)", PicType: parse.Alpha, PicCount: 10},
+								},
+							},
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			opts: []Option{WithNaming(Naming{
+				Prefixes:      []string{"WS-"},
+				Abbreviations: map[string]string{"ADDR": "ADDRESS", "CUST": "CUSTOMER"},
+				Renames:       map[string]string{"WS-CUST-ADDR-LINE-1": "Street"},
+				Unexported:    true,
+			})},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+package main
+
+// Copybook contains a representation of Copybook
+type Copybook struct {
+	customerRec CustomerRec ` + "`pic:\"1,14,clause=X(14)\"`" + ` // start:1 end:14
+}
+
+// CustomerRec contains a representation of WS-CUST-REC
+type CustomerRec struct {
+	customerID      uint            ` + "`pic:\"1,4,intdigits=4,clause=9(04)\"`" + ` // start:1 end:4
+	customerCode    string          ` + "`pic:\"1,4,clause=X(04)\"`" + `             // start:1 end:4 REDEFINES customerID
+	customerAddress CustomerAddress ` + "`pic:\"5,14,clause=X(10)\"`" + `            // start:5 end:14
+}
+
+// CustomerAddress contains a representation of WS-CUST-ADDR
+type CustomerAddress struct {
+	street string ` + "`pic:\"1,10,clause=X(10)\"`" + ` // start:5 end:14
+}
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithCollidingNames_ReturnsGoStructsWithResolvedNames": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "PARTIES",
+					Children: []*parse.Record{
+						{
+							Level:      5,
+							Identifier: "CUSTOMER",
+							Children: []*parse.Record{
+								{
+									Level:      10,
+									Identifier: "ADDRESS",
+									Children: []*parse.Record{
+										{
+											Level:      15,
+											Identifier: "LINE",
+											Pic:        parse.Picture{PicString: "X(05)", PicType: parse.Alpha, PicCount: 5},
+										},
+									},
+								},
+							},
+						},
+						{
+							Level:      5,
+							Identifier: "SUPPLIER",
+							Children: []*parse.Record{
+								{
+									Level:      10,
+									Identifier: "ADDRESS",
+									Children: []*parse.Record{
+										{
+											Level:      15,
+											Identifier: "LINE",
+											Pic:        parse.Picture{PicString: "X(05)", PicType: parse.Alpha, PicCount: 5},
+										},
+									},
+								},
+								{
+									Level:      10,
+									Identifier: "SUPPLIER-ID",
+									Pic:        parse.Picture{PicString: "X(03)", PicType: parse.Alpha, PicCount: 3},
+								},
+								{
+									Level:      10,
+									Identifier: "SUPPLIER_ID",
+									Pic:        parse.Picture{PicString: "X(03)", PicType: parse.Alpha, PicCount: 3},
+								},
+							},
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+package main
+
+// Copybook contains a representation of Copybook
+type Copybook struct {
+	Parties Parties ` + "`pic:\"1,16,clause=X(16)\"`" + ` // start:1 end:16
+}
+
+// Parties contains a representation of PARTIES
+type Parties struct {
+	Customer Customer ` + "`pic:\"1,5,clause=X(05)\"`" + `  // start:1 end:5
+	Supplier Supplier ` + "`pic:\"6,16,clause=X(11)\"`" + ` // start:6 end:16
+}
+
+// Customer contains a representation of CUSTOMER
+type Customer struct {
+	Address Address ` + "`pic:\"1,5,clause=X(05)\"`" + ` // start:1 end:5
+}
+
+// Address contains a representation of ADDRESS
+type Address struct {
+	Line string ` + "`pic:\"1,5,clause=X(05)\"`" + ` // start:1 end:5
+}
+
+// Supplier contains a representation of SUPPLIER
+type Supplier struct {
+	Address     SupplierAddress ` + "`pic:\"1,5,clause=X(05)\"`" + `  // start:6 end:10
+	SupplierID  string          ` + "`pic:\"6,8,clause=X(03)\"`" + `  // start:11 end:13
+	SupplierID2 string          ` + "`pic:\"9,11,clause=X(03)\"`" + ` // start:14 end:16
+}
+
+// SupplierAddress contains a representation of ADDRESS
+type SupplierAddress struct {
+	Line string ` + "`pic:\"1,5,clause=X(05)\"`" + ` // start:6 end:10
+}
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithTags_ReturnsGoStructsWithTags": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "WS-CUST-REC",
+					Children: []*parse.Record{
+						{
+							Level:      5,
+							Identifier: "WS-CUST-ID",
+							Pic:        parse.Picture{PicString: "9(04)", PicType: parse.Unsigned, PicCount: 4, IntegerDigits: 4},
+						},
+						{
+							Level:      5,
+							Identifier: "WS-CUST-CODE",
+							Redefines:  "WS-CUST-ID",
+							Pic:        parse.Picture{PicString: "X(04)", PicType: parse.Alpha, PicCount: 4},
+						},
+						{
+							Level:      5,
+							Identifier: "WS-CUST-NAME",
+							Pic:        parse.Picture{PicString: "X(10)", PicType: parse.Alpha, PicCount: 10},
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			opts: []Option{
+				WithNaming(Naming{Prefixes: []string{"WS-"}}),
+				WithTags([]Tag{{Key: "json", Case: TagSnake, OmitEmpty: OmitEmptyRedefines}, {Key: "db", Case: TagCOBOL}}),
+			},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+package main
+
+// Copybook contains a representation of Copybook
+type Copybook struct {
+	CustRec CustRec ` + "`pic:\"1,14,clause=X(14)\" json:\"cust_rec\" db:\"WS-CUST-REC\"`" + ` // start:1 end:14
+}
+
+// CustRec contains a representation of WS-CUST-REC
+type CustRec struct {
+	CustID   uint   ` + "`pic:\"1,4,intdigits=4,clause=9(04)\" json:\"cust_id\" db:\"WS-CUST-ID\"`" + `   // start:1 end:4
+	CustCode string ` + "`pic:\"1,4,clause=X(04)\" json:\"cust_code,omitempty\" db:\"WS-CUST-CODE\"`" + ` // start:1 end:4 REDEFINES CustID
+	CustName string ` + "`pic:\"5,14,clause=X(10)\" json:\"cust_name\" db:\"WS-CUST-NAME\"`" + `          // start:5 end:14
+}
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithDecimalRules_ReturnsGoStructsWithRuleTypesAndImports": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "ACCOUNT",
+					Children: []*parse.Record{
+						{
+							Level:      5,
+							Identifier: "BALANCE",
+							Pic: parse.Picture{
+								PicString: "S9(13)V99", PicType: parse.Decimal, PicCount: 15,
+								IntegerDigits: 13, FractionDigits: 2, Signed: true,
+							},
+						},
+						{
+							Level:      5,
+							Identifier: "RATE",
+							Pic: parse.Picture{
+								PicString: "9(03)V9(06)", PicType: parse.Decimal, PicCount: 9,
+								IntegerDigits: 3, FractionDigits: 6,
+							},
+						},
+						{
+							Level:      5,
+							Identifier: "TOTAL",
+							Pic: parse.Picture{
+								PicString: "9(31)V99", PicType: parse.Decimal, PicCount: 33,
+								IntegerDigits: 31, FractionDigits: 2,
+							},
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			opts: []Option{WithDecimalRules([]DecimalRule{
+				{MaxPrecision: 18, MaxScale: 4, GoType: "int64"},
+				{MaxPrecision: 18, GoType: "github.com/shopspring/decimal.Decimal"},
+				{GoType: "*math/big.Rat"},
+			})},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+package main
+
+import (
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// Copybook contains a representation of Copybook
+type Copybook struct {
+	Account Account ` + "`pic:\"1,57,clause=X(57)\"`" + ` // start:1 end:57
+}
+
+// Account contains a representation of ACCOUNT
+type Account struct {
+	Balance int64           ` + "`pic:\"1,15,intdigits=13,fracdigits=2,signed,clause=S9(13)V99\"`" + ` // start:1 end:15
+	Rate    decimal.Decimal ` + "`pic:\"16,24,intdigits=3,fracdigits=6,clause=9(03)V9(06)\"`" + `      // start:16 end:24
+	Total   *big.Rat        ` + "`pic:\"25,57,intdigits=31,fracdigits=2,clause=9(31)V99\"`" + `        // start:25 end:57
+}
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithDateFields_ReturnsGoStructsWithDateMethods": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "PAYMENT",
+					Children: []*parse.Record{
+						{
+							Level:      5,
+							Identifier: "VALUE-DATE",
+							Pic:        parse.Picture{PicString: "9(08)", PicType: parse.Unsigned, PicCount: 8, IntegerDigits: 8},
+							Comment:    "@date(YYYYMMDD)",
+						},
+						{
+							Level:      5,
+							Identifier: "BOOKED-DATE",
+							Pic:        parse.Picture{PicString: "X(06)", PicType: parse.Alpha, PicCount: 6},
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			opts:          []Option{WithDateFields([]DateField{mustDateField(t, "BOOKED-DATE", DateCYYDDD)})},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Copybook contains a representation of Copybook
+type Copybook struct {
+	Payment Payment ` + "`pic:\"1,14,clause=X(14)\"`" + ` // start:1 end:14
+}
+
+// Payment contains a representation of PAYMENT
+type Payment struct {
+	ValueDate  uint   ` + "`pic:\"1,8,intdigits=8,clause=9(08)\"`" + ` // start:1 end:8
+	BookedDate string ` + "`pic:\"9,14,clause=X(06)\"`" + `            // start:9 end:14
+}
+
+// ValueDateTime returns ValueDate, which holds a YYYYMMDD date, as a time.Time in UTC. It
+// returns an error if ValueDate is not a valid date.
+func (p *Payment) ValueDateTime() (time.Time, error) {
+	value := fmt.Sprintf("%08d", p.ValueDate)
+	date, err := time.Parse("20060102", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("ValueDate %q is not a valid YYYYMMDD date: %w", value, err)
+	}
+	return date, nil
+}
+
+// SetValueDateTime sets ValueDate to a time.Time as a YYYYMMDD date. It returns an error if
+// the year of the time cannot be held by the format.
+func (p *Payment) SetValueDateTime(date time.Time) error {
+	if date.Year() < 1 || date.Year() > 9999 {
+		return fmt.Errorf("year %d of ValueDate cannot be held by a YYYYMMDD date", date.Year())
+	}
+	value := date.Format("20060102")
+	// The value only has digits, so it is always a number.
+	number, _ := strconv.Atoi(value)
+	p.ValueDate = uint(number)
+	return nil
+}
+
+// BookedDateTime returns BookedDate, which holds a CYYDDD date, as a time.Time in UTC. It
+// returns an error if BookedDate is not a valid date.
+func (p *Payment) BookedDateTime() (time.Time, error) {
+	value := p.BookedDate
+	if len(value) != 6 || value[0] < '0' || value[0] > '9' {
+		return time.Time{}, fmt.Errorf("BookedDate %q is not a valid CYYDDD date", value)
+	}
+	// The century digit is the number of centuries since 1900.
+	date, err := time.Parse("2006002", fmt.Sprint(19+int(value[0]-'0'), value[1:]))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("BookedDate %q is not a valid CYYDDD date: %w", value, err)
+	}
+	return date, nil
+}
+
+// SetBookedDateTime sets BookedDate to a time.Time as a CYYDDD date. It returns an error if
+// the year of the time cannot be held by the format.
+func (p *Payment) SetBookedDateTime(date time.Time) error {
+	if date.Year() < 1900 || date.Year() > 2899 {
+		return fmt.Errorf("year %d of BookedDate cannot be held by a CYYDDD date", date.Year())
+	}
+	value := fmt.Sprint(date.Year()/100-19, date.Format("2006002")[2:])
+	p.BookedDate = value
+	return nil
+}
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithValidation_ReturnsGoStructsWithValidateMethods": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "ORDER",
+					Children: []*parse.Record{
+						{
+							Level:      5,
+							Identifier: "STATUS",
+							Pic:        parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2},
+							Conditions: []parse.Condition{
+								{Identifier: "OPEN", Values: []parse.ValueRange{{From: parse.Literal{Kind: parse.AlphanumericLiteral, Text: "OP"}}}},
+								{Identifier: "CLOSED", Values: []parse.ValueRange{{From: parse.Literal{Kind: parse.AlphanumericLiteral, Text: "CL"}}}},
+							},
+						},
+						{
+							Level:       5,
+							Identifier:  "LINE",
+							OccursCount: 2,
+							Children: []*parse.Record{
+								{
+									Level:      10,
+									Identifier: "QUANTITY",
+									Pic:        parse.Picture{PicString: "S9(03)", PicType: parse.Signed, PicCount: 3, IntegerDigits: 3, Signed: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			opts:          []Option{WithValidation(true)},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Copybook contains a representation of Copybook
+type Copybook struct {
+	Order Order ` + "`pic:\"1,8,clause=X(08)\"`" + ` // start:1 end:8
+}
+
+// Validate checks the fields of Copybook against the constraints of their PIC clauses and
+// condition names, and returns all the violations with the paths and positions of their fields.
+func (c *Copybook) Validate() error {
+	return errors.Join(c.validate("", 0)...)
+}
+
+// validate returns the violations of the fields of Copybook, whose paths are prefixed by path
+// and whose positions are shifted by shift for an element of a table.
+func (c *Copybook) validate(path string, shift int) []error {
+	var errs []error
+	errs = append(errs, c.Order.validate(path+"Order.", shift)...)
+	return errs
+}
+
+// Order contains a representation of ORDER
+type Order struct {
+	Status string  ` + "`pic:\"1,2,clause=X(02)\"`" + `   // start:1 end:2
+	Line   [2]Line ` + "`pic:\"3,8,2,clause=X(03)\"`" + ` // start:3 end:8
+}
+
+// Validate checks the fields of Order against the constraints of their PIC clauses and
+// condition names, and returns all the violations with the paths and positions of their fields.
+func (o *Order) Validate() error {
+	return errors.Join(o.validate("", 0)...)
+}
+
+// validate returns the violations of the fields of Order, whose paths are prefixed by path
+// and whose positions are shifted by shift for an element of a table.
+func (o *Order) validate(path string, shift int) []error {
+	var errs []error
+	if value := strings.TrimRight(o.Status, " "); utf8.RuneCountInString(value) > 2 {
+		errs = append(errs, fmt.Errorf("%sStatus (start:%d end:%d): %q has more than 2 characters", path, shift+1, shift+2, value))
+	}
+	if value := strings.TrimRight(o.Status, " "); value != "OP" && value != "CL" {
+		errs = append(errs, fmt.Errorf("%sStatus (start:%d end:%d): %q is not a value of OPEN, CLOSED", path, shift+1, shift+2, value))
+	}
+	for index := range o.Line {
+		errs = append(errs, o.Line[index].validate(fmt.Sprintf("%sLine[%d].", path, index), shift+index*3)...)
+	}
+	return errs
+}
+
+// Line contains a representation of LINE
+type Line struct {
+	Quantity int ` + "`pic:\"1,3,intdigits=3,signed,clause=S9(03)\"`" + ` // start:3 end:5
+}
+
+// Validate checks the fields of Line against the constraints of their PIC clauses and
+// condition names, and returns all the violations with the paths and positions of their fields.
+func (l *Line) Validate() error {
+	return errors.Join(l.validate("", 0)...)
+}
+
+// validate returns the violations of the fields of Line, whose paths are prefixed by path
+// and whose positions are shifted by shift for an element of a table.
+func (l *Line) validate(path string, shift int) []error {
+	var errs []error
+	if value := l.Quantity; value > 999 || value < -999 {
+		errs = append(errs, fmt.Errorf("%sQuantity (start:%d end:%d): %d has more than 3 digits", path, shift+3, shift+5, value))
+	}
+	return errs
+}
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithRedefinesVariants_ReturnsGoStructsWithVariantTypes": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "PAYMENT",
+					Children: []*parse.Record{
+						{
+							Level:      5,
+							Identifier: "DETAIL-TYPE",
+							Pic:        parse.Picture{PicString: "X(01)", PicType: parse.Alpha, PicCount: 1},
+							Conditions: []parse.Condition{
+								{Identifier: "IS-TEXT", Values: []parse.ValueRange{{From: parse.Literal{Kind: parse.AlphanumericLiteral, Text: "T"}}}},
+								{Identifier: "IS-NUMBER", Values: []parse.ValueRange{{From: parse.Literal{Kind: parse.AlphanumericLiteral, Text: "N"}}}},
+							},
+						},
+						{
+							Level:      5,
+							Identifier: "DETAIL",
+							Pic:        parse.Picture{PicString: "X(06)", PicType: parse.Alpha, PicCount: 6},
+							Comment:    "@when(IS-TEXT)",
+						},
+						{
+							Level:      5,
+							Identifier: "DETAIL-NUMBER",
+							Redefines:  "DETAIL",
+							Pic:        parse.Picture{PicString: "9(04)", PicType: parse.Unsigned, PicCount: 4, IntegerDigits: 4},
+							Comment:    "@when(IS-NUMBER)",
+						},
+						{
+							Level:      5,
+							Identifier: "TRAILER",
+							Pic:        parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2},
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			opts:          []Option{WithRedefinesVariants(true)},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Copybook contains a representation of Copybook
+type Copybook struct {
+	Payment Payment ` + "`pic:\"1,9,clause=X(09)\"`" + ` // start:1 end:9
+}
+
+// Payment contains a representation of PAYMENT
+type Payment struct {
+	DetailType string         ` + "`pic:\"1,1,clause=X(01)\"`" + ` // start:1 end:1
+	Detail     DetailVariants ` + "`pic:\"2,7,clause=X(06)\"`" + ` // start:2 end:7
+	Trailer    string         ` + "`pic:\"8,9,clause=X(02)\"`" + ` // start:8 end:9
+}
+
+// ActiveDetail decodes the variant of Detail that is selected by the condition names of
+// DetailType. It returns an error if none of them is true.
+func (p *Payment) ActiveDetail(codec CopybookCodec) (any, error) {
+	switch value := strings.TrimRight(p.DetailType, " "); {
+	case value == "T":
+		return p.Detail.AsDetail(codec)
+	case value == "N":
+		return p.Detail.AsDetailNumber(codec)
+	default:
+		return nil, fmt.Errorf("DetailType %q selects no variant of Detail", value)
+	}
+}
+
+// DetailVariants holds the bytes of Detail, which the fields that redefine it share. Each of
+// them is a variant of the bytes, which is decoded and encoded by a CopybookCodec.
+type DetailVariants [6]byte
+
+// AsDetail decodes the bytes as the Detail variant.
+func (v *DetailVariants) AsDetail(codec CopybookCodec) (string, error) {
+	var view struct {
+		Detail string ` + "`pic:\"1,6,clause=X(06)\"`" + `
+	}
+	if err := codec.Unmarshal(v[:6], &view); err != nil {
+		return view.Detail, fmt.Errorf("failed to decode Detail: %w", err)
+	}
+	return view.Detail, nil
+}
+
+// SetDetail encodes a value as the Detail variant into the bytes, leaving the bytes after
+// it as they are.
+func (v *DetailVariants) SetDetail(codec CopybookCodec, value string) error {
+	view := struct {
+		Detail string ` + "`pic:\"1,6,clause=X(06)\"`" + `
+	}{value}
+	data, err := codec.Marshal(&view)
+	if err != nil {
+		return fmt.Errorf("failed to encode Detail: %w", err)
+	}
+	if len(data) != 6 {
+		return fmt.Errorf("Detail is encoded in %d bytes instead of 6", len(data))
+	}
+	copy(v[:], data)
+	return nil
+}
+
+// AsDetailNumber decodes the bytes as the DetailNumber variant.
+func (v *DetailVariants) AsDetailNumber(codec CopybookCodec) (uint, error) {
+	var view struct {
+		DetailNumber uint ` + "`pic:\"1,4,intdigits=4,clause=9(04)\"`" + `
+	}
+	if err := codec.Unmarshal(v[:4], &view); err != nil {
+		return view.DetailNumber, fmt.Errorf("failed to decode DetailNumber: %w", err)
+	}
+	return view.DetailNumber, nil
+}
+
+// SetDetailNumber encodes a value as the DetailNumber variant into the bytes, leaving the bytes after
+// it as they are.
+func (v *DetailVariants) SetDetailNumber(codec CopybookCodec, value uint) error {
+	view := struct {
+		DetailNumber uint ` + "`pic:\"1,4,intdigits=4,clause=9(04)\"`" + `
+	}{value}
+	data, err := codec.Marshal(&view)
+	if err != nil {
+		return fmt.Errorf("failed to encode DetailNumber: %w", err)
+	}
+	if len(data) != 4 {
+		return fmt.Errorf("DetailNumber is encoded in %d bytes instead of 4", len(data))
+	}
+	copy(v[:], data)
+	return nil
+}
+
+// CopybookCodec decodes and encodes the variants of REDEFINES fields from and to their bytes, by the pic tags of
+// the structs it is given, like the structs of the copybook are decoded and encoded.
+type CopybookCodec interface {
+	Unmarshal(data []byte, v any) error
+	Marshal(v any) ([]byte, error)
+}
 `),
 			assertError: assert.NoError,
 		},
@@ -168,12 +1118,43 @@ type Copybook struct {
 			typeOverrides: map[parse.PicType]string{},
 			assertError:   assert.Error,
 		},
+		"Invalid_UnresolvedRedefines_ReturnsError": {
+			input: []*parse.Record{
+				{
+					Line:       1,
+					Level:      1,
+					Identifier: "RECORD",
+					Children: []*parse.Record{
+						{Line: 2, Level: 5, Identifier: "FIELD1", Pic: parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2}},
+						{
+							Line: 3, Level: 5, Identifier: "FIELD2", Redefines: "FIELD3",
+							Pic: parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2},
+						},
+					},
+				},
+			},
+			assertError: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.EqualError(t, err, "REDEFINES FIELD3 of FIELD2 on line 3 is not found in group RECORD")
+			},
+		},
+		"Invalid_UnresolvedLevel01Redefines_ReturnsError": {
+			input: []*parse.Record{
+				{Line: 1, Level: 1, Identifier: "RECORD1", Pic: parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2}},
+				{
+					Line: 2, Level: 1, Identifier: "RECORD2", Redefines: "RECORD0",
+					Pic: parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2},
+				},
+			},
+			assertError: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.EqualError(t, err, "REDEFINES RECORD0 of RECORD2 on line 2 is not found in group Copybook")
+			},
+		},
 	}
 
 	for name, test := range tests {
 		tt := test
 		t.Run(name, func(t *testing.T) {
-			got, err := ToGoStructsData(tt.input, "Copybook", "main", tt.typeOverrides)
+			got, err := ToGoStructsData(tt.input, "Copybook", "main", tt.typeOverrides, tt.opts...)
 			tt.assertError(t, err)
 			assert.Equal(t, got, tt.expected)
 		})
@@ -202,6 +1183,8 @@ func Test_buildFieldsData(t *testing.T) {
 					PicTag:         "1,10,clause=X(10)",
 					PicGlobalStart: 1,
 					PicGlobalEnd:   10,
+					Identifier:     "RECORD-2",
+					Pic:            parse.Picture{PicString: "X(10)", PicType: parse.Alpha, PicCount: 10},
 				},
 			},
 		},
@@ -238,6 +1221,8 @@ func Test_buildFieldsData(t *testing.T) {
 					PicTag:         "1,10,clause=X(10)",
 					PicGlobalStart: 1,
 					PicGlobalEnd:   10,
+					Identifier:     "RECORD-1",
+					Pic:            parse.Picture{PicString: "X(10)", PicType: parse.Alpha, PicCount: 10},
 				},
 				{
 					FieldVarName:   "Record2",
@@ -246,6 +1231,9 @@ func Test_buildFieldsData(t *testing.T) {
 					PicTag:         "11,20,2,clause=X(05)",
 					PicGlobalStart: 11,
 					PicGlobalEnd:   20,
+					Identifier:     "RECORD-2",
+					OccursCount:    2,
+					StructVarName:  "Record2",
 				},
 				{
 					FieldVarName:   "Record4",
@@ -254,6 +1242,8 @@ func Test_buildFieldsData(t *testing.T) {
 					PicTag:         "21,38,clause=9(15)V99",
 					PicGlobalStart: 21,
 					PicGlobalEnd:   38,
+					Identifier:     "RECORD-4",
+					Pic:            parse.Picture{PicString: "9(15)V99", PicType: parse.Decimal, PicCount: 18},
 				},
 			},
 		},
@@ -279,6 +1269,9 @@ func Test_buildFieldsData(t *testing.T) {
 					PicTag:         "1,2,clause=X(02)",
 					PicGlobalStart: 1,
 					PicGlobalEnd:   2,
+					Identifier:     "PARENT-RECORD-FILLER1",
+					Pic:            parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2},
+					Filler:         true,
 				},
 				{
 					FieldVarName:   "ParentRecordFiller2",
@@ -287,6 +1280,9 @@ func Test_buildFieldsData(t *testing.T) {
 					PicTag:         "3,7,clause=X(05)",
 					PicGlobalStart: 3,
 					PicGlobalEnd:   7,
+					Identifier:     "PARENT-RECORD-FILLER2",
+					Pic:            parse.Picture{PicString: "X(05)", PicType: parse.Alpha, PicCount: 5},
+					Filler:         true,
 				},
 			},
 		},
@@ -318,6 +1314,8 @@ func Test_buildFieldsData(t *testing.T) {
 					PicTag:         "1,10,clause=X(10)",
 					PicGlobalStart: 1,
 					PicGlobalEnd:   10,
+					Identifier:     "RECORD-1",
+					Pic:            parse.Picture{PicString: "X(10)", PicType: parse.Alpha, PicCount: 10},
 				},
 				{
 					FieldVarName:     "Record2",
@@ -327,6 +1325,8 @@ func Test_buildFieldsData(t *testing.T) {
 					PicTag:           "1,10,clause=X(10)",
 					PicGlobalStart:   1,
 					PicGlobalEnd:     10,
+					Identifier:       "RECORD-2",
+					Pic:              parse.Picture{PicString: "X(10)", PicType: parse.Alpha, PicCount: 10},
 				},
 				{
 					FieldVarName:   "Record3",
@@ -335,6 +1335,62 @@ func Test_buildFieldsData(t *testing.T) {
 					PicTag:         "11,28,clause=9(15)V99",
 					PicGlobalStart: 11,
 					PicGlobalEnd:   28,
+					Identifier:     "RECORD-3",
+					Pic:            parse.Picture{PicString: "9(15)V99", PicType: parse.Decimal, PicCount: 18},
+				},
+			},
+		},
+		"MultipleFieldsWithSmallerRedefinesPosChange": {
+			parentName: "PARENT-RECORD",
+			records: []*parse.Record{
+				{
+					Level:      5,
+					Identifier: "RECORD-1",
+					Pic:        parse.Picture{PicString: "X(10)", PicType: parse.Alpha, PicCount: 10},
+				},
+				{
+					Level:      5,
+					Identifier: "RECORD-2",
+					Pic:        parse.Picture{PicString: "X(04)", PicType: parse.Alpha, PicCount: 4},
+					Redefines:  "RECORD-1",
+				},
+				{
+					Level:      5,
+					Identifier: "RECORD-3",
+					Pic:        parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2},
+				},
+			},
+			expected: []FieldData{
+				{
+					FieldVarName:   "Record1",
+					VarType:        "string",
+					PicSize:        10,
+					PicTag:         "1,10,clause=X(10)",
+					PicGlobalStart: 1,
+					PicGlobalEnd:   10,
+					Identifier:     "RECORD-1",
+					Pic:            parse.Picture{PicString: "X(10)", PicType: parse.Alpha, PicCount: 10},
+				},
+				{
+					FieldVarName:     "Record2",
+					VarType:          "string",
+					RedefinesVarName: "Record1",
+					PicSize:          4,
+					PicTag:           "1,4,clause=X(04)",
+					PicGlobalStart:   1,
+					PicGlobalEnd:     4,
+					Identifier:       "RECORD-2",
+					Pic:              parse.Picture{PicString: "X(04)", PicType: parse.Alpha, PicCount: 4},
+				},
+				{
+					FieldVarName:   "Record3",
+					VarType:        "string",
+					PicSize:        2,
+					PicTag:         "11,12,clause=X(02)",
+					PicGlobalStart: 11,
+					PicGlobalEnd:   12,
+					Identifier:     "RECORD-3",
+					Pic:            parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2},
 				},
 			},
 		},
@@ -343,13 +1399,31 @@ func Test_buildFieldsData(t *testing.T) {
 	for name, test := range tests {
 		tt := test
 		t.Run(name, func(t *testing.T) {
-			goGen := goGenerator{newPositionTracker(), defaultTypeMapping()}
-			got := goGen.buildFieldsData(tt.records, tt.parentName)
+			goGen := newGoGenerator(defaultTypeMapping())
+			got, err := goGen.buildFieldsData(tt.records, tt.parentName)
+			assert.NoError(t, err)
 			assert.Equal(t, tt.expected, got)
 		})
 	}
 }
 
+func Test_toSnakeIdentifier(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected string
+	}{
+		"Hyphenated":     {"RECORD-1", "record_1"},
+		"Colon":          {"X-:XXXX:-DBT", "x__xxxx__dbt"},
+		"LeadingNumeral": {"1ST-FIELD", "_1st_field"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, toSnakeIdentifier(tt.input))
+		})
+	}
+}
+
 func Test_getVarType(t *testing.T) {
 	tests := map[string]struct {
 		rec      *parse.Record
@@ -383,6 +1457,28 @@ func Test_getVarType(t *testing.T) {
 			varName:  "AtptDbtCpStructure",
 			expected: "[3]AtptDbtCpStructure",
 		},
+		"PointerRecord": {
+			rec:      &parse.Record{Usage: parse.Pointer},
+			expected: "Pointer",
+		},
+		"FloatRecord": {
+			rec:      &parse.Record{Usage: parse.Float},
+			expected: "float32",
+		},
+		"DoubleRecordWithOccurs": {
+			rec:      &parse.Record{Usage: parse.Double, OccursCount: 2},
+			expected: "[2]float64",
+		},
+		"GroupWithUsage": {
+			rec: &parse.Record{
+				Usage: parse.Pointer,
+				Children: []*parse.Record{
+					{Usage: parse.Pointer},
+				},
+			},
+			varName:  "Pointers",
+			expected: "Pointers",
+		},
 	}
 
 	for name, test := range tests {
@@ -398,22 +1494,20 @@ func Test_getPicSize(t *testing.T) {
 	tests := map[string]struct {
 		input       *parse.Record
 		expected    int
-		expectPanic bool
+		expectedErr string
 	}{
 		"PicRecord": {
 			input: &parse.Record{
 				Pic: parse.Picture{PicCount: 5},
 			},
-			expected:    5,
-			expectPanic: false,
+			expected: 5,
 		},
 		"PicRecordWithOccurs": {
 			input: &parse.Record{
 				Pic:         parse.Picture{PicCount: 3},
 				OccursCount: 4,
 			},
-			expected:    12,
-			expectPanic: false,
+			expected: 12,
 		},
 		"RecordWithChildren": {
 			input: &parse.Record{
@@ -422,8 +1516,7 @@ func Test_getPicSize(t *testing.T) {
 					{Pic: parse.Picture{PicCount: 3}},
 				},
 			},
-			expected:    5,
-			expectPanic: false,
+			expected: 5,
 		},
 		"RecordWithNestedChildren": {
 			input: &parse.Record{
@@ -435,8 +1528,7 @@ func Test_getPicSize(t *testing.T) {
 					}},
 				},
 			},
-			expected:    15,
-			expectPanic: false,
+			expected: 15,
 		},
 		"OccursRecordWithChildren": {
 			input: &parse.Record{
@@ -446,8 +1538,7 @@ func Test_getPicSize(t *testing.T) {
 					{Pic: parse.Picture{PicCount: 3}},
 				},
 			},
-			expected:    15,
-			expectPanic: false,
+			expected: 15,
 		},
 		"RecordWithChildRedefinesSameSize": {
 			input: &parse.Record{
@@ -456,8 +1547,7 @@ func Test_getPicSize(t *testing.T) {
 					{Pic: parse.Picture{PicCount: 5}, Redefines: "REDEFINED-FIELD"},
 				},
 			},
-			expected:    5,
-			expectPanic: false,
+			expected: 5,
 		},
 		"RecordWithChildRedefinesThatChangesSize": {
 			input: &parse.Record{
@@ -466,42 +1556,52 @@ func Test_getPicSize(t *testing.T) {
 					{Pic: parse.Picture{PicCount: 6}, Redefines: "REDEFINED-FIELD"},
 				},
 			},
-			expected:    6,
-			expectPanic: false,
+			expected: 6,
+		},
+		"PointerRecord": {
+			input:    &parse.Record{Usage: parse.Pointer, OccursCount: 3},
+			expected: 12,
+		},
+		"ProcedurePointerRecord": {
+			input:    &parse.Record{Usage: parse.ProcedurePointer},
+			expected: 8,
 		},
-		"InvalidRedefines_Panics": {
+		"InvalidRedefines_ReturnsError": {
 			input: &parse.Record{
 				Identifier: "GROUP3",
 				Children: []*parse.Record{
 					{
+						Line:       4,
 						Identifier: "INVALID-REDEFINES",
 						Redefines:  "NONEXISTENT",
 						Pic:        parse.Picture{PicCount: 3},
 					},
 				},
 			},
-			expectPanic: true,
+			expectedErr: "REDEFINES NONEXISTENT of INVALID-REDEFINES on line 4 is not found in group GROUP3",
 		},
 	}
 
 	for name, test := range tests {
 		tt := test
 		t.Run(name, func(t *testing.T) {
-			if tt.expectPanic {
-				assert.Panics(t, func() { calculateSize(tt.input) })
-			} else {
-				got := calculateSize(tt.input)
-				assert.Equal(t, tt.expected, got)
+			got, err := newGoGenerator(defaultTypeMapping()).calculateSize(tt.input)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
 			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
 		})
 	}
 }
 
 func Test_getPicTag(t *testing.T) {
 	tests := map[string]struct {
-		rec       *parse.Record
-		fieldSize int
-		expected  string
+		rec        *parse.Record
+		fieldSize  int
+		dimensions []Dimension
+		expected   string
 	}{
 		"PicRecord": {
 			rec: &parse.Record{
@@ -510,6 +1610,13 @@ func Test_getPicTag(t *testing.T) {
 			fieldSize: 5,
 			expected:  "1,5,clause=X(05)",
 		},
+		"LowerCasePicRecord": {
+			rec: &parse.Record{
+				Pic: parse.Picture{PicString: "s9(03)v99", IntegerDigits: 3, FractionDigits: 2, Signed: true},
+			},
+			fieldSize: 5,
+			expected:  "1,5,intdigits=3,fracdigits=2,signed,clause=S9(03)V99",
+		},
 		"PicRecordWithOccurs": {
 			rec: &parse.Record{
 				Pic:         parse.Picture{PicString: "9(03)"},
@@ -518,6 +1625,67 @@ func Test_getPicTag(t *testing.T) {
 			fieldSize: 12,
 			expected:  "1,12,4,clause=9(03)",
 		},
+		"PicRecordWithNumericDetails": {
+			rec: &parse.Record{
+				Pic: parse.Picture{PicString: "S9(03)V9(2)", IntegerDigits: 3, FractionDigits: 2, Signed: true},
+			},
+			fieldSize: 6,
+			expected:  "1,6,intdigits=3,fracdigits=2,signed,clause=S9(03)V9(2)",
+		},
+		"EditedPicRecordWithScaling": {
+			rec: &parse.Record{
+				Pic: parse.Picture{PicString: "ZZ9PP,-", IntegerDigits: 3, ScaleFactor: 2, Signed: true, Edited: true},
+			},
+			fieldSize: 5,
+			expected:  "1,5,intdigits=3,scalefactor=2,signed,edited,clause=ZZ9PP,-",
+		},
+		"NationalPicRecord": {
+			rec: &parse.Record{
+				Pic: parse.Picture{PicString: "N(05)", PicType: parse.National},
+			},
+			fieldSize: 10,
+			expected:  "1,10,enc=utf16be,clause=N(05)",
+		},
+		"MultiDimensionalPicRecord": {
+			rec: &parse.Record{
+				Pic:         parse.Picture{PicString: "9(03)", IntegerDigits: 3},
+				OccursCount: 4,
+			},
+			fieldSize:  12,
+			dimensions: []Dimension{{Count: 2, Stride: 37}, {Count: 3, Stride: 12}, {Count: 4, Stride: 3}},
+			expected:   "1,12,4,dims=2;3;4,strides=37;12;3,intdigits=3,clause=9(03)",
+		},
+		"JustifiedPicRecord": {
+			rec: &parse.Record{
+				Pic:       parse.Picture{PicString: "X(15)", PicType: parse.Alpha},
+				Justified: true,
+			},
+			fieldSize: 15,
+			expected:  "1,15,justified,clause=X(15)",
+		},
+		"BlankWhenZeroPicRecord": {
+			rec: &parse.Record{
+				Pic:           parse.Picture{PicString: "9(05)V99", IntegerDigits: 5, FractionDigits: 2},
+				BlankWhenZero: true,
+			},
+			fieldSize: 7,
+			expected:  "1,7,intdigits=5,fracdigits=2,blankwhenzero,clause=9(05)V99",
+		},
+		"PointerRecord": {
+			rec: &parse.Record{
+				Usage: parse.Pointer,
+			},
+			fieldSize: 4,
+			expected:  "1,4,usage=pointer",
+		},
+		"PackedDecimalRecord": {
+			rec: &parse.Record{
+				Pic:   parse.Picture{PicString: "S9(03)V99", IntegerDigits: 3, FractionDigits: 2, Signed: true},
+				Usage: parse.PackedDecimal,
+			},
+			fieldSize: 3,
+			expected:  "1,3,usage=packed-decimal,intdigits=3,fracdigits=2,signed,clause=S9(03)V99",
+		},
 		"RecordWithChildren": {
 			rec: &parse.Record{
 				Children: []*parse.Record{
@@ -544,7 +1712,7 @@ func Test_getPicTag(t *testing.T) {
 	for name, test := range tests {
 		tt := test
 		t.Run(name, func(t *testing.T) {
-			got := getPicTag(tt.rec, tt.fieldSize, 1)
+			got := getPicTag(tt.rec, tt.fieldSize, 1, tt.dimensions)
 			assert.Equal(t, tt.expected, got)
 		})
 	}
@@ -559,12 +1727,14 @@ func TestPositionTracker_GetStoredPos(t *testing.T) {
 	}
 
 	t.Run("ExistingIdentifier_ReturnsPositions", func(t *testing.T) {
-		localStart, globalStart := tracker.getStoredPos("FIELD2")
+		localStart, globalStart, ok := tracker.getStoredPos("FIELD2")
+		assert.True(t, ok)
 		assert.Equal(t, 5, localStart)
 		assert.Equal(t, 10, globalStart)
 	})
 
-	t.Run("NonExistingIdentifier_Panics", func(t *testing.T) {
-		assert.Panics(t, func() { tracker.getStoredPos("NONEXISTENT") })
+	t.Run("NonExistingIdentifier_ReturnsNotFound", func(t *testing.T) {
+		_, _, ok := tracker.getStoredPos("NONEXISTENT")
+		assert.False(t, ok)
 	})
 }
@@ -0,0 +1,51 @@
+package generate
+
+import (
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// majorVersion matches the major version suffix of a module path, e.g. v2 of github.com/org/module/v2.
+var majorVersion = regexp.MustCompile(`^v[0-9]+$`)
+
+// qualifyType shortens a Go type that is qualified by the import path of its package, such as
+// github.com/shopspring/decimal.Decimal or [3]math/big.Rat, to a type qualified by the name of its
+// package, and records the import of the package. Other types are returned as they are, and their
+// imports are left to the formatting of the generated code.
+func (g *goGenerator) qualifyType(goType string) string {
+	slash := strings.LastIndex(goType, "/")
+	if slash < 0 {
+		return goType
+	}
+	dot := strings.LastIndex(goType[slash:], ".")
+	if dot < 0 {
+		return goType
+	}
+	dot += slash
+
+	// Array, slice and pointer types are qualified after their prefix, e.g. [3]*.
+	prefixEnd := strings.LastIndexAny(goType[:slash], "]*") + 1
+	importPath := goType[prefixEnd:dot]
+	g.imports[importPath] = true
+
+	return goType[:prefixEnd] + packageName(importPath) + goType[dot:]
+}
+
+// packageName returns the conventional name of the package of an import path, which is its last element
+// without a major version suffix or a dotted version, e.g. yaml for gopkg.in/yaml.v3.
+func packageName(importPath string) string {
+	elements := strings.Split(importPath, "/")
+	name := elements[len(elements)-1]
+	if majorVersion.MatchString(name) && len(elements) > 1 {
+		name = elements[len(elements)-2]
+	}
+	name, _, _ = strings.Cut(name, ".")
+	return name
+}
+
+// sortedImports returns the import paths of the qualified types used by the generated code.
+func (g *goGenerator) sortedImports() []string {
+	return slices.Sorted(maps.Keys(g.imports))
+}
@@ -0,0 +1,31 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_qualifyType(t *testing.T) {
+	tests := map[string]struct {
+		goType          string
+		expected        string
+		expectedImports []string
+	}{
+		"Builtin":            {goType: "int64", expected: "int64"},
+		"PackageName":        {goType: "decimal.Decimal", expected: "decimal.Decimal"},
+		"ImportPath":         {goType: "github.com/shopspring/decimal.Decimal", expected: "decimal.Decimal", expectedImports: []string{"github.com/shopspring/decimal"}},
+		"StandardImportPath": {goType: "math/big.Rat", expected: "big.Rat", expectedImports: []string{"math/big"}},
+		"ArrayOfPointers":    {goType: "[3]*math/big.Rat", expected: "[3]*big.Rat", expectedImports: []string{"math/big"}},
+		"MajorVersion":       {goType: "github.com/org/money/v2.Amount", expected: "money.Amount", expectedImports: []string{"github.com/org/money/v2"}},
+		"DottedVersion":      {goType: "gopkg.in/inf.v0.Dec", expected: "inf.Dec", expectedImports: []string{"gopkg.in/inf.v0"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newGoGenerator(defaultTypeMapping())
+			assert.Equal(t, tt.expected, g.qualifyType(tt.goType))
+			assert.Equal(t, tt.expectedImports, g.sortedImports())
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package generate
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Naming configures how COBOL identifiers are converted to Go names.
+type Naming struct {
+	// Prefixes are stripped from the start of identifiers, e.g. WS- from WS-CUST-NAME. Only the first
+	// matching prefix is stripped.
+	Prefixes []string
+	// Abbreviations expand the words of identifiers, e.g. ADDR to ADDRESS.
+	Abbreviations map[string]string
+	// Renames give the Go names of specific identifiers, which replace the other rules.
+	Renames map[string]string
+	// Unexported makes the names of fields unexported. The names of types are always exported.
+	Unexported bool
+}
+
+// typeName returns the Go name of the type generated for a record.
+func (n Naming) typeName(identifier string) string {
+	if rename, ok := n.rename(identifier); ok {
+		return upperFirst(rename)
+	}
+
+	return toGoName(n.expandAbbreviations(n.stripPrefix(identifier)))
+}
+
+// fieldName returns the Go name of the field generated for a record.
+func (n Naming) fieldName(identifier string) string {
+	name := n.typeName(identifier)
+	if !n.Unexported {
+		return name
+	}
+
+	return lowerFirstWord(name)
+}
+
+func (n Naming) rename(identifier string) (string, bool) {
+	for from, to := range n.Renames {
+		if identifierKey(from) == identifierKey(identifier) {
+			return to, true
+		}
+	}
+	return "", false
+}
+
+// stripPrefix strips the first matching prefix from an identifier, unless nothing would be left of it.
+func (n Naming) stripPrefix(identifier string) string {
+	for _, prefix := range n.Prefixes {
+		if len(identifier) > len(prefix) && identifierKey(identifier[:len(prefix)]) == identifierKey(prefix) {
+			if stripped := strings.TrimLeft(identifier[len(prefix):], "-:"); stripped != "" {
+				return stripped
+			}
+		}
+	}
+	return identifier
+}
+
+func (n Naming) expandAbbreviations(identifier string) string {
+	if len(n.Abbreviations) == 0 {
+		return identifier
+	}
+
+	abbreviations := make(map[string]string, len(n.Abbreviations))
+	for abbreviation, expansion := range n.Abbreviations {
+		abbreviations[identifierKey(abbreviation)] = expansion
+	}
+
+	words := strings.Split(identifier, "-")
+	for i, word := range words {
+		if expansion, ok := abbreviations[identifierKey(word)]; ok {
+			words[i] = expansion
+		}
+	}
+	return strings.Join(words, "-")
+}
+
+// lowerFirstWord lowers the first word of a Go name, which may be an initialism, e.g. RateID to rateID
+// and IDCode to idCode.
+func lowerFirstWord(name string) string {
+	runes := []rune(name)
+	for i := range runes {
+		// Lower the leading initialism, but not the first letter of the word after it.
+		if !unicode.IsUpper(runes[i]) || (i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
+
+// receiverName returns the name of the receiver of the methods of a struct, which is the first letter of
+// its name in lower case, or s if it has none.
+func receiverName(structVarName string) string {
+	if r, _ := utf8.DecodeRuneInString(structVarName); unicode.IsLetter(r) {
+		return string(unicode.ToLower(r))
+	}
+	return "s"
+}
+
+func upperFirst(name string) string {
+	runes := []rune(name)
+	if len(runes) > 0 {
+		runes[0] = unicode.ToUpper(runes[0])
+	}
+	return string(runes)
+}
@@ -0,0 +1,82 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNaming(t *testing.T) {
+	naming := Naming{
+		Prefixes:      []string{"WS-", "LK-"},
+		Abbreviations: map[string]string{"ADDR": "ADDRESS", "cust": "CUSTOMER"},
+		Renames:       map[string]string{"WS-CUST-ID": "customerNumber"},
+	}
+
+	tests := map[string]struct {
+		naming            Naming
+		identifier        string
+		expectedTypeName  string
+		expectedFieldName string
+	}{
+		"Default": {
+			identifier:        "WS-CUST-ADDR-LINE-1",
+			expectedTypeName:  "WsCustAddrLine1",
+			expectedFieldName: "WsCustAddrLine1",
+		},
+		"PrefixAndAbbreviations": {
+			naming:            naming,
+			identifier:        "WS-CUST-ADDR-LINE-1",
+			expectedTypeName:  "CustomerAddressLine1",
+			expectedFieldName: "CustomerAddressLine1",
+		},
+		"LowerCaseIdentifier": {
+			naming:            naming,
+			identifier:        "lk-cust-addr",
+			expectedTypeName:  "CustomerAddress",
+			expectedFieldName: "CustomerAddress",
+		},
+		"PrefixIsWholeIdentifier": {
+			naming:            naming,
+			identifier:        "WS-",
+			expectedTypeName:  "Ws",
+			expectedFieldName: "Ws",
+		},
+		"Rename": {
+			naming:            naming,
+			identifier:        "ws-cust-id",
+			expectedTypeName:  "CustomerNumber",
+			expectedFieldName: "CustomerNumber",
+		},
+		"Unexported": {
+			naming:            Naming{Prefixes: naming.Prefixes, Unexported: true},
+			identifier:        "WS-ID-CODE",
+			expectedTypeName:  "IDCode",
+			expectedFieldName: "idCode",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedTypeName, tt.naming.typeName(tt.identifier))
+			assert.Equal(t, tt.expectedFieldName, tt.naming.fieldName(tt.identifier))
+		})
+	}
+}
+
+func Test_receiverName(t *testing.T) {
+	tests := map[string]struct {
+		structVarName string
+		expected      string
+	}{
+		"Exported":        {"Record", "r"},
+		"Empty":           {"", "s"},
+		"NoLeadingLetter": {"_Record", "s"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, receiverName(tt.structVarName))
+		})
+	}
+}
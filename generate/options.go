@@ -0,0 +1,80 @@
+package generate
+
+// defaultPointerWidth is the width in bytes of a pointer in a 31-bit program.
+const defaultPointerWidth = 4
+
+// Option configures how the layout of a copybook is generated.
+type Option func(*goGenerator)
+
+// WithPointerWidth sets the width in bytes of POINTER, FUNCTION-POINTER and INDEX items, which is 4 for
+// 31-bit programs and 8 for 64-bit programs. PROCEDURE-POINTER items are twice as wide.
+func WithPointerWidth(width int) Option {
+	return func(g *goGenerator) {
+		g.pointerWidth = width
+	}
+}
+
+// WithNaming sets how COBOL identifiers are converted to Go names.
+func WithNaming(naming Naming) Option {
+	return func(g *goGenerator) {
+		g.naming = naming
+	}
+}
+
+// WithTags sets the struct tags that the generated fields get in addition to the pic tag, in order.
+func WithTags(tags []Tag) Option {
+	return func(g *goGenerator) {
+		g.tags = tags
+	}
+}
+
+// WithDecimalRules sets the rules that map decimals to Go types by their precision and scale, replacing
+// DefaultDecimalRules. The first rule that matches a decimal applies, and decimals that match no rule are
+// mapped by their PIC type, so that no rules map all decimals by their PIC type. Decimals whose digits are
+// unknown match no rule.
+func WithDecimalRules(rules []DecimalRule) Option {
+	return func(g *goGenerator) {
+		g.decimalRules = rules
+	}
+}
+
+// WithFieldOverrides sets the Go types of the fields that match the patterns of the overrides. The first
+// override that matches a field applies.
+func WithFieldOverrides(overrides []FieldOverride) Option {
+	return func(g *goGenerator) {
+		g.fieldOverrides = overrides
+	}
+}
+
+// WithDateFields sets the date formats of the fields that match the patterns of the date fields, which
+// get methods that convert them to and from a time.Time. The first date field that matches a field
+// applies, and the @date annotation of a field takes precedence over them.
+func WithDateFields(dateFields []DateField) Option {
+	return func(g *goGenerator) {
+		g.dateFields = dateFields
+	}
+}
+
+// WithValidation sets whether the structs get a Validate method, which checks their fields against the
+// constraints of their PIC clauses and condition names.
+func WithValidation(validation bool) Option {
+	return func(g *goGenerator) {
+		g.validation = validation
+	}
+}
+
+// WithRedefinesVariants sets whether the fields that share their bytes through REDEFINES are replaced by a
+// type holding the bytes, with methods that decode and encode each field as a variant of the bytes.
+func WithRedefinesVariants(variants bool) Option {
+	return func(g *goGenerator) {
+		g.redefinesVariants = variants
+	}
+}
+
+// WithWarningHandler sets the handler of warnings about the generated code, such as the renames of
+// names that collide.
+func WithWarningHandler(handler func(warning string)) Option {
+	return func(g *goGenerator) {
+		g.warn = handler
+	}
+}
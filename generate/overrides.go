@@ -0,0 +1,127 @@
+package generate
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+// FieldOverride maps the elementary fields whose name matches a pattern to a Go type, which takes
+// precedence over the decimal rules and the mapping of their PIC type. The Go type may be qualified by
+// the import path of its package, e.g. cloud.google.com/go/civil.Date.
+type FieldOverride struct {
+	fieldPattern
+	goType string
+}
+
+// NewFieldOverride creates a FieldOverride. The pattern is one of the following, matched case-insensitively
+// against the qualified name of a field, such as TXN-REC.WS-TXN-DATE:
+//   - A name, optionally qualified by the names of the groups it is in, e.g. WS-TXN-DATE or
+//     TXN-REC.WS-TXN-DATE, which matches the end of the qualified name.
+//   - A glob in the same form, e.g. *-TS or TXN-REC.*, where each name may use the wildcards of path.Match.
+//   - A regular expression between slashes, e.g. /^TXN-REC\..*-TS$/, which matches the whole qualified name.
+func NewFieldOverride(pattern, goType string) (FieldOverride, error) {
+	if goType == "" {
+		return FieldOverride{}, fmt.Errorf("field override %q must have a Go type", pattern)
+	}
+
+	p, err := newFieldPattern(pattern)
+	if err != nil {
+		return FieldOverride{}, fmt.Errorf("field override %w", err)
+	}
+	return FieldOverride{fieldPattern: p, goType: goType}, nil
+}
+
+// fieldPattern matches the qualified names of fields, as described by NewFieldOverride.
+type fieldPattern struct {
+	pattern string
+	// regexp is the compiled pattern of a regular expression pattern.
+	regexp *regexp.Regexp
+}
+
+func newFieldPattern(pattern string) (fieldPattern, error) {
+	if pattern == "" {
+		return fieldPattern{}, fmt.Errorf("pattern must not be empty")
+	}
+
+	if expr, ok := regexpPattern(pattern); ok {
+		re, err := regexp.Compile("(?i)" + expr)
+		if err != nil {
+			return fieldPattern{}, fmt.Errorf("%q is not a valid regular expression: %w", pattern, err)
+		}
+		return fieldPattern{pattern: pattern, regexp: re}, nil
+	}
+
+	for _, name := range strings.Split(pattern, ".") {
+		if _, err := path.Match(name, ""); err != nil || name == "" {
+			return fieldPattern{}, fmt.Errorf("%q is not a valid qualified name or glob", pattern)
+		}
+	}
+	return fieldPattern{pattern: pattern}, nil
+}
+
+func regexpPattern(pattern string) (string, bool) {
+	if len(pattern) > 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		return pattern[1 : len(pattern)-1], true
+	}
+	return "", false
+}
+
+// matches reports whether the qualified name of a field matches the pattern.
+func (p fieldPattern) matches(qualifiedName string) bool {
+	if p.regexp != nil {
+		return p.regexp.MatchString(qualifiedName)
+	}
+
+	patternNames := strings.Split(identifierKey(p.pattern), ".")
+	names := strings.Split(identifierKey(qualifiedName), ".")
+	if len(patternNames) > len(names) {
+		return false
+	}
+	names = names[len(names)-len(patternNames):]
+	for i, patternName := range patternNames {
+		if ok, _ := path.Match(patternName, names[i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// qualifyNames records the qualified names of the records of a copybook, which are the identifiers of the
+// groups they are in and their own identifier joined by dots.
+func (g *goGenerator) qualifyNames(records []*parse.Record, parentName string) {
+	for _, rec := range records {
+		qualifiedName := rec.Identifier
+		if parentName != "" {
+			qualifiedName = parentName + "." + rec.Identifier
+		}
+		g.qualifiedNames[rec] = qualifiedName
+		g.qualifyNames(rec.Children, qualifiedName)
+	}
+}
+
+// qualifiedName returns the qualified name of a record, or its identifier for a record outside a copybook.
+func (g *goGenerator) qualifiedName(rec *parse.Record) string {
+	if qualifiedName, ok := g.qualifiedNames[rec]; ok {
+		return qualifiedName
+	}
+	return rec.Identifier
+}
+
+// fieldOverride returns the Go type of the first field override that matches an elementary record.
+func (g *goGenerator) fieldOverride(rec *parse.Record) (string, bool) {
+	if len(rec.Children) > 0 {
+		return "", false
+	}
+
+	qualifiedName := g.qualifiedName(rec)
+	for _, override := range g.fieldOverrides {
+		if override.matches(qualifiedName) {
+			return override.goType, true
+		}
+	}
+	return "", false
+}
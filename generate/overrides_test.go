@@ -0,0 +1,119 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+func TestNewFieldOverride(t *testing.T) {
+	tests := map[string]struct {
+		pattern     string
+		goType      string
+		assertError assert.ErrorAssertionFunc
+	}{
+		"Name":               {pattern: "WS-TXN-DATE", goType: "civil.Date", assertError: assert.NoError},
+		"QualifiedName":      {pattern: "TXN-REC.WS-TXN-DATE", goType: "civil.Date", assertError: assert.NoError},
+		"Glob":               {pattern: "*-TS", goType: "time.Time", assertError: assert.NoError},
+		"Regexp":             {pattern: `/^TXN-REC\..*-TS$/`, goType: "time.Time", assertError: assert.NoError},
+		"EmptyPattern":       {pattern: "", goType: "time.Time", assertError: assert.Error},
+		"EmptyType":          {pattern: "*-TS", goType: "", assertError: assert.Error},
+		"EmptyQualifier":     {pattern: "TXN-REC..WS-TXN-DATE", goType: "civil.Date", assertError: assert.Error},
+		"InvalidGlob":        {pattern: "[-TS", goType: "time.Time", assertError: assert.Error},
+		"InvalidRegexp":      {pattern: "/(-TS/", goType: "time.Time", assertError: assert.Error},
+		"EmptyRegexp_IsName": {pattern: "//", goType: "time.Time", assertError: assert.NoError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewFieldOverride(tt.pattern, tt.goType)
+			tt.assertError(t, err)
+		})
+	}
+}
+
+func TestFieldOverride_matches(t *testing.T) {
+	tests := map[string]struct {
+		pattern       string
+		qualifiedName string
+		expected      bool
+	}{
+		"Name":                          {pattern: "WS-TXN-DATE", qualifiedName: "TXN-REC.WS-TXN-DATE", expected: true},
+		"Name_CaseInsensitive":          {pattern: "ws-txn-date", qualifiedName: "TXN-REC.WS-TXN-DATE", expected: true},
+		"Name_OtherField":               {pattern: "WS-TXN-DATE", qualifiedName: "TXN-REC.WS-TXN-DATE-2", expected: false},
+		"QualifiedName":                 {pattern: "TXN-REC.WS-TXN-DATE", qualifiedName: "FILE.TXN-REC.WS-TXN-DATE", expected: true},
+		"QualifiedName_OtherGroup":      {pattern: "TXN-REC.WS-TXN-DATE", qualifiedName: "FILE.AUDIT.WS-TXN-DATE", expected: false},
+		"QualifiedName_LongerThanField": {pattern: "FILE.TXN-REC.WS-TXN-DATE", qualifiedName: "TXN-REC.WS-TXN-DATE", expected: false},
+		"Glob":                          {pattern: "*-TS", qualifiedName: "TXN-REC.CREATED-TS", expected: true},
+		"Glob_DoesNotCrossGroups":       {pattern: "TXN-*", qualifiedName: "TXN-REC.CREATED-TS", expected: false},
+		"QualifiedGlob":                 {pattern: "TXN-REC.*", qualifiedName: "TXN-REC.CREATED-TS", expected: true},
+		"Regexp":                        {pattern: `/^TXN-REC\..*-TS$/`, qualifiedName: "txn-rec.created-ts", expected: true},
+		"Regexp_OtherGroup":             {pattern: `/^TXN-REC\..*-TS$/`, qualifiedName: "AUDIT.CREATED-TS", expected: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			override, err := NewFieldOverride(tt.pattern, "time.Time")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, override.matches(tt.qualifiedName))
+		})
+	}
+}
+
+func Test_varType_FieldOverrides(t *testing.T) {
+	newOverride := func(pattern, goType string) FieldOverride {
+		override, err := NewFieldOverride(pattern, goType)
+		require.NoError(t, err)
+		return override
+	}
+	date := &parse.Record{Identifier: "WS-TXN-DATE", Pic: parse.Picture{PicType: parse.Unsigned, IntegerDigits: 8}}
+	amount := &parse.Record{Identifier: "WS-TXN-AMOUNT", Pic: parse.Picture{PicType: parse.Decimal, IntegerDigits: 5, FractionDigits: 2}}
+	times := &parse.Record{Identifier: "WS-UPDATED-TS", Pic: parse.Picture{PicType: parse.Alpha, PicCount: 26}, OccursCount: 3}
+	group := &parse.Record{Identifier: "WS-TXN-DATES", Children: []*parse.Record{date}}
+	ast := []*parse.Record{{Identifier: "TXN-REC", Children: []*parse.Record{group, amount, times}}}
+
+	tests := map[string]struct {
+		rec             *parse.Record
+		typeName        string
+		expected        string
+		expectedImports []string
+	}{
+		"QualifiedName_OverridesPicType": {
+			rec:             date,
+			expected:        "civil.Date",
+			expectedImports: []string{"cloud.google.com/go/civil"},
+		},
+		"Name_OverridesDecimalRules": {
+			rec:      amount,
+			expected: "string",
+		},
+		"Glob_OccursArrayOfOverrideType": {
+			rec:      times,
+			expected: "[3]time.Time",
+		},
+		"Group_NotOverridden": {
+			rec:      group,
+			typeName: "WsTxnDates",
+			expected: "WsTxnDates",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newGoGenerator(defaultTypeMapping(),
+				WithDecimalRules([]DecimalRule{{GoType: "int64"}}),
+				WithFieldOverrides([]FieldOverride{
+					newOverride("TXN-REC.*.WS-TXN-DATE", "cloud.google.com/go/civil.Date"),
+					newOverride("ws-txn-amount", "string"),
+					newOverride("*-TS", "time.Time"),
+					newOverride("*", "int"),
+				}))
+			g.qualifyNames(ast, "")
+			assert.Equal(t, tt.expected, g.varType(tt.rec, tt.typeName))
+			assert.Equal(t, tt.expectedImports, g.sortedImports())
+		})
+	}
+}
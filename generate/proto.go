@@ -0,0 +1,238 @@
+package generate
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yasv98/copybooktogo/parse"
+	"github.com/yasv98/copybooktogo/util/generic"
+)
+
+const protoGenTemplate = `// This file is generated by copybooktogo. DO NOT EDIT.
+
+syntax = "proto3";
+
+package {{ .Package }};
+{{ if .Imports }}
+{{ range .Imports }}import "{{ . }}";
+{{ end }}{{ end }}
+{{- range .Messages }}
+// {{ .Name }} contains a representation of {{ .Identifier }}
+message {{ .Name }} {
+{{- range .Wrappers }}
+  message {{ .Name }} {
+    repeated {{ .Type }} items = 1;
+  }
+{{- end }}
+{{- range .Entries }}
+{{- if .Oneof }}
+  oneof {{ .Oneof }} {
+{{- range .Fields }}
+    {{ .Type }} {{ .Name }} = {{ .Number }}; // {{ .Comment }}
+{{- end }}
+  }
+{{- else }}
+{{- range .Fields }}
+  {{ if .Repeated }}repeated {{ end }}{{ .Type }} {{ .Name }} = {{ .Number }}; // {{ .Comment }}
+{{- end }}
+{{- end }}
+{{- end }}
+}
+{{ end }}`
+
+type protoParams struct {
+	Package  string
+	Imports  []string
+	Messages []protoMessage
+}
+
+type protoMessage struct {
+	Name       string
+	Identifier string
+	Wrappers   []protoWrapper
+	Entries    []protoEntry
+}
+
+// protoEntry is either a single field, or a oneof holding a redefined field and all of its redefinitions.
+type protoEntry struct {
+	Oneof  string
+	Fields []protoField
+}
+
+// protoWrapper is a nested message that carries an OCCURS field within a oneof, as
+// repeated fields cannot be members of a oneof.
+type protoWrapper struct {
+	Name string
+	Type string
+}
+
+type protoField struct {
+	Repeated bool
+	Type     string
+	Name     string
+	Number   int
+	Comment  string
+}
+
+// protoWellKnownImports maps well known protobuf types to the file that must be imported to use them.
+var protoWellKnownImports = map[string]string{
+	"google.type.Decimal":       "google/type/decimal.proto",
+	"google.type.Date":          "google/type/date.proto",
+	"google.protobuf.Timestamp": "google/protobuf/timestamp.proto",
+}
+
+// ToProto generates a Protocol Buffers schema from a COBOL copybook AST.
+//
+// Groups become messages, OCCURS become repeated fields and a field together with the fields that
+// REDEFINES it become a oneof. Elementary FILLER fields and slack bytes hold no values and are left out.
+// Field numbers are the positions of the fields in their message, so they are stable across runs for the
+// same copybook, but a field inserted before others renumbers them, which breaks wire compatibility.
+// Sibling fields whose identifiers have the same proto name, such as A-B and A:B, are numbered and
+// reported as warnings.
+func ToProto(ast []*parse.Record, copybookName, packageName string, typeOverrides map[parse.PicType]string, opts ...Option) ([]byte, error) {
+	if len(ast) == 0 {
+		return nil, fmt.Errorf("ast is empty")
+	}
+
+	g := newGoGenerator(defaultTypeMapping(), opts...)
+	structs, err := g.buildCopybookStructData(copybookName, ast)
+	if err != nil {
+		return nil, err
+	}
+	structs = withoutSlack(structs)
+	// Merge default PIC type mappings with any configured overrides.
+	picTypeMapping := generic.MergeMaps(defaultProtoTypeMapping(), typeOverrides)
+
+	data := protoParams{
+		Package:  packageName,
+		Messages: generic.Map(func(s StructData) protoMessage { return g.toProtoMessage(s, picTypeMapping) }, structs),
+	}
+	data.Imports = protoImports(data.Messages)
+
+	return executeTemplate(protoGenTemplate, data)
+}
+
+func (g *goGenerator) toProtoMessage(s StructData, picTypeMapping map[parse.PicType]string) protoMessage {
+	msg := protoMessage{Name: s.StructVarName, Identifier: s.Identifier}
+	// Fields and oneofs share the names of a message.
+	usedNames := make(map[string]string)
+
+	// Elementary FILLER fields hold no values, so they get no proto fields and take no field numbers.
+	fields := slices.DeleteFunc(slices.Clone(s.Fields), func(f FieldData) bool { return f.Filler && f.StructVarName == "" })
+
+	// Redefining fields are grouped with the field they redefine, which may itself be a redefinition.
+	oneofIndex := make(map[string]int)
+	rootOf := make(map[string]string)
+	for i, field := range fields {
+		root := field.FieldVarName
+		if field.RedefinesVarName != "" {
+			root = rootOf[field.RedefinesVarName]
+		}
+		rootOf[field.FieldVarName] = root
+
+		pf := protoField{
+			Repeated: field.OccursCount > 1,
+			Type:     protoType(field, picTypeMapping),
+			Name:     resolveSiblingName(g.warn, "proto field", field.Identifier, toProtoName(field.Identifier), s.Identifier, usedNames),
+			Number:   i + 1,
+			Comment:  fmt.Sprint("start:", field.PicGlobalStart, " end:", field.PicGlobalEnd),
+		}
+
+		entryIndex, isRedefinition := oneofIndex[root]
+		if !isRedefinition && !hasRedefinitions(fields[i+1:], field.FieldVarName) {
+			msg.Entries = append(msg.Entries, protoEntry{Fields: []protoField{pf}})
+			continue
+		}
+
+		if pf.Repeated {
+			wrapper := protoWrapper{Name: field.FieldVarName + "List", Type: pf.Type}
+			msg.Wrappers = append(msg.Wrappers, wrapper)
+			pf.Repeated = false
+			pf.Type = wrapper.Name
+		}
+
+		if !isRedefinition {
+			oneofIndex[root] = len(msg.Entries)
+			oneof := resolveSiblingName(g.warn, "proto oneof", field.Identifier, pf.Name+"_variant", s.Identifier, usedNames)
+			msg.Entries = append(msg.Entries, protoEntry{Oneof: oneof})
+			entryIndex = oneofIndex[root]
+		}
+		msg.Entries[entryIndex].Fields = append(msg.Entries[entryIndex].Fields, pf)
+	}
+
+	return msg
+}
+
+func hasRedefinitions(fields []FieldData, varName string) bool {
+	return slices.ContainsFunc(fields, func(f FieldData) bool { return f.RedefinesVarName == varName })
+}
+
+func protoType(field FieldData, picTypeMapping map[parse.PicType]string) string {
+	if field.StructVarName != "" {
+		return field.StructVarName
+	}
+	if field.Usage.IsOpaque() {
+		return "bytes"
+	}
+	if floatType, ok := protoFloatTypes[field.Usage]; ok {
+		return floatType
+	}
+
+	protoType, ok := picTypeMapping[field.Pic.PicType]
+	if !ok {
+		// Default to string if no mapping is found.
+		protoType = "string"
+	}
+	return protoType
+}
+
+func protoImports(messages []protoMessage) []string {
+	var protoImports []string
+	for _, msg := range messages {
+		for _, entry := range msg.Entries {
+			for _, field := range entry.Fields {
+				if path, ok := protoWellKnownImports[field.Type]; ok && !slices.Contains(protoImports, path) {
+					protoImports = append(protoImports, path)
+				}
+			}
+		}
+		for _, wrapper := range msg.Wrappers {
+			if path, ok := protoWellKnownImports[wrapper.Type]; ok && !slices.Contains(protoImports, path) {
+				protoImports = append(protoImports, path)
+			}
+		}
+	}
+	slices.Sort(protoImports)
+	return protoImports
+}
+
+// toProtoName converts a COBOL identifier to the lower snake case used for protobuf field names.
+func toProtoName(identifier string) string {
+	name := strings.ToLower(strings.NewReplacer("-", "_", ":", "_").Replace(identifier))
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		// Protobuf identifiers must start with a letter.
+		name = "f_" + name
+	}
+	return name
+}
+
+// protoFloatTypes maps the floating-point usages, which have no PIC clause, to protobuf scalar types.
+var protoFloatTypes = map[parse.Usage]string{
+	parse.Float:  "float",
+	parse.Double: "double",
+}
+
+func defaultProtoTypeMapping() map[parse.PicType]string {
+	return map[parse.PicType]string{
+		parse.Unsigned:      "uint64",
+		parse.Signed:        "int64",
+		parse.Decimal:       "string",
+		parse.Alpha:         "string",
+		parse.NumericEdited: "string",
+		parse.AlphaEdited:   "string",
+		parse.National:      "string",
+		parse.DBCS:          "string",
+		parse.Unknown:       "string",
+	}
+}
@@ -0,0 +1,287 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+func Test_ToProto(t *testing.T) {
+	tests := map[string]struct {
+		input         []*parse.Record
+		typeOverrides map[parse.PicType]string
+		expected      []byte
+		assertError   assert.ErrorAssertionFunc
+	}{
+		"Valid_Copybook_ReturnsProtoSchema": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "RECORD-1",
+					Children: []*parse.Record{
+						{
+							Level:      5,
+							Identifier: "RECORD-2",
+							Pic:        parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2},
+						},
+						{
+							Level:       5,
+							Identifier:  "RECORD-3",
+							OccursCount: 3,
+							Children: []*parse.Record{
+								{
+									Level:      7,
+									Identifier: "RECORD-4",
+									Pic:        parse.Picture{PicString: "S9(07)", PicType: parse.Signed, PicCount: 8},
+								},
+							},
+						},
+						{
+							Level:      5,
+							Identifier: "RECORD-5",
+							Pic:        parse.Picture{PicString: "9(15)V99", PicType: parse.Decimal, PicCount: 18},
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+syntax = "proto3";
+
+package main;
+
+// Copybook contains a representation of Copybook
+message Copybook {
+  Record1 record_1 = 1; // start:1 end:44
+}
+
+// Record1 contains a representation of RECORD-1
+message Record1 {
+  string record_2 = 1; // start:1 end:2
+  repeated Record3 record_3 = 2; // start:3 end:26
+  string record_5 = 3; // start:27 end:44
+}
+
+// Record3 contains a representation of RECORD-3
+message Record3 {
+  int64 record_4 = 1; // start:3 end:10
+}
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithRedefines_ReturnsProtoSchemaWithOneof": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "RECORD-1",
+					Children: []*parse.Record{
+						{
+							Level:      5,
+							Identifier: "RECORD-2",
+							Pic:        parse.Picture{PicString: "X(04)", PicType: parse.Alpha, PicCount: 4},
+						},
+						{
+							Level:      5,
+							Identifier: "RECORD-3",
+							Redefines:  "RECORD-2",
+							Pic:        parse.Picture{PicString: "9(04)", PicType: parse.Unsigned, PicCount: 4},
+						},
+						{
+							Level:       5,
+							Identifier:  "RECORD-4",
+							Redefines:   "RECORD-2",
+							OccursCount: 2,
+							Pic:         parse.Picture{PicString: "9(02)", PicType: parse.Unsigned, PicCount: 2},
+						},
+						{
+							Level:      5,
+							Identifier: "RECORD-5",
+							Pic:        parse.Picture{PicString: "X(01)", PicType: parse.Alpha, PicCount: 1},
+						},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+syntax = "proto3";
+
+package main;
+
+// Copybook contains a representation of Copybook
+message Copybook {
+  Record1 record_1 = 1; // start:1 end:5
+}
+
+// Record1 contains a representation of RECORD-1
+message Record1 {
+  message Record4List {
+    repeated uint64 items = 1;
+  }
+  oneof record_2_variant {
+    string record_2 = 1; // start:1 end:4
+    uint64 record_3 = 2; // start:1 end:4
+    Record4List record_4 = 3; // start:1 end:4
+  }
+  string record_5 = 4; // start:5 end:5
+}
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithTypeOverrides_ReturnsProtoSchemaWithImports": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "RECORD",
+					Pic:        parse.Picture{PicString: "9(15)V99", PicType: parse.Decimal, PicCount: 18},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{
+				parse.Decimal: "google.type.Decimal",
+			},
+			expected: []byte(`// This file is generated by copybooktogo. DO NOT EDIT.
+
+syntax = "proto3";
+
+package main;
+
+import "google/type/decimal.proto";
+
+// Copybook contains a representation of Copybook
+message Copybook {
+  google.type.Decimal record = 1; // start:1 end:18
+}
+`),
+			assertError: assert.NoError,
+		},
+		"Invalid_EmptyCopybook_ReturnsError": {
+			input:         []*parse.Record{},
+			expected:      nil,
+			typeOverrides: map[parse.PicType]string{},
+			assertError:   assert.Error,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ToProto(tt.input, "Copybook", "main", tt.typeOverrides)
+			tt.assertError(t, err)
+			assert.Equal(t, string(tt.expected), string(got))
+		})
+	}
+}
+
+func Test_ToProto_DuplicateNames_Renamed(t *testing.T) {
+	input := []*parse.Record{
+		{
+			Level:      1,
+			Identifier: "RECORD",
+			Children: []*parse.Record{
+				{Level: 5, Identifier: "A-B", Pic: parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2}},
+				{Level: 5, Identifier: "C", Redefines: "A-B", Pic: parse.Picture{PicString: "9(02)", PicType: parse.Unsigned, PicCount: 2}},
+				{Level: 5, Identifier: "A:B", Pic: parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2}},
+				{Level: 5, Identifier: "A-B-VARIANT", Pic: parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2}},
+			},
+		},
+	}
+	expected := `// This file is generated by copybooktogo. DO NOT EDIT.
+
+syntax = "proto3";
+
+package main;
+
+// Copybook contains a representation of Copybook
+message Copybook {
+  Record record = 1; // start:1 end:6
+}
+
+// Record contains a representation of RECORD
+message Record {
+  oneof a_b_variant {
+    string a_b = 1; // start:1 end:2
+    uint64 c = 2; // start:1 end:2
+  }
+  string a_b_2 = 3; // start:3 end:4
+  string a_b_variant_2 = 4; // start:5 end:6
+}
+`
+	var warnings []string
+
+	got, err := ToProto(input, "Copybook", "main", map[parse.PicType]string{},
+		WithWarningHandler(func(warning string) { warnings = append(warnings, warning) }))
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(got))
+	assert.Equal(t, []string{
+		"field A:B of RECORD renamed from AB to AB2, as AB is already used by A-B",
+		"proto field A:B of RECORD renamed from a_b to a_b_2, as a_b is already used by A-B",
+		"proto field A-B-VARIANT of RECORD renamed from a_b_variant to a_b_variant_2, as a_b_variant is already used by A-B",
+	}, warnings)
+}
+
+func Test_ToProto_FillerAndSlack_NotNumbered(t *testing.T) {
+	input := []*parse.Record{
+		{
+			Level:      1,
+			Identifier: "RECORD",
+			Children: []*parse.Record{
+				{Level: 5, Identifier: "A", Pic: parse.Picture{PicString: "X", PicType: parse.Alpha, PicCount: 1}},
+				{Level: 5, Identifier: "FILLER", Pic: parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2}},
+				{
+					Level: 5, Identifier: "B", Usage: parse.Binary, Synchronized: true,
+					Pic: parse.Picture{PicString: "S9(9)", PicType: parse.Signed, PicCount: 10, IntegerDigits: 9, Signed: true},
+				},
+				{Level: 5, Identifier: "FILLER", Children: []*parse.Record{
+					{Level: 10, Identifier: "C", Pic: parse.Picture{PicString: "X", PicType: parse.Alpha, PicCount: 1}},
+				}},
+			},
+		},
+	}
+	expected := `// This file is generated by copybooktogo. DO NOT EDIT.
+
+syntax = "proto3";
+
+package main;
+
+// Copybook contains a representation of Copybook
+message Copybook {
+  Record record = 1; // start:1 end:9
+}
+
+// Record contains a representation of RECORD
+message Record {
+  string a = 1; // start:1 end:1
+  int64 b = 2; // start:5 end:8
+  RecordFiller2 record_filler2 = 3; // start:9 end:9
+}
+
+// RecordFiller2 contains a representation of RECORD-FILLER2
+message RecordFiller2 {
+  string c = 1; // start:9 end:9
+}
+`
+
+	got, err := ToProto(input, "Copybook", "main", map[parse.PicType]string{})
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(got))
+}
+
+func Test_toProtoName(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected string
+	}{
+		"Hyphenated":     {"RECORD-1", "record_1"},
+		"Colon":          {"X-:XXXX:-DBT", "x__xxxx__dbt"},
+		"LeadingNumeral": {"1ST-FIELD", "f_1st_field"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, toProtoName(tt.input))
+		})
+	}
+}
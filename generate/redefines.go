@@ -0,0 +1,77 @@
+package generate
+
+import (
+	"fmt"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+// checkRedefines reports the REDEFINES clauses that break the rules of COBOL as warnings, as the layout
+// of the records sharing their bytes may then not match the data. A record that redefines another:
+//   - Must be at the same level as the record it redefines.
+//   - Must immediately follow the record it redefines, or another record that redefines it.
+//   - Must not be larger than the record it redefines, unless they are level 01 records. A larger record
+//     is laid out with the bytes of the largest record.
+//   - Must not redefine a record with an OCCURS clause.
+//
+// A REDEFINES clause whose record is not found among the records before it is left to the layout, which
+// returns it as an error.
+func (g *goGenerator) checkRedefines(records []*parse.Record) error {
+	for i, rec := range records {
+		if len(rec.Children) > 0 {
+			if err := g.checkRedefines(rec.Children); err != nil {
+				return err
+			}
+		}
+		if rec.Redefines == "" {
+			continue
+		}
+		target := findRedefined(records[:i], rec.Redefines)
+		if target == nil {
+			continue
+		}
+
+		invalid := func(reason string) {
+			g.warn(fmt.Sprintf("REDEFINES %s of %s is invalid, as %s", rec.Redefines, rec.Identifier, reason))
+		}
+		if rec.Level != target.Level {
+			invalid(fmt.Sprintf("it is at level %02d but %s is at level %02d", rec.Level, target.Identifier, target.Level))
+		}
+		// Only the records that redefine the same record may be between a record and its redefinition.
+		previous := i - 1
+		for previous >= 0 && records[previous] != target && records[previous].Redefines != "" {
+			previous--
+		}
+		if previous < 0 || records[previous] != target {
+			invalid(fmt.Sprintf("it does not immediately follow %s or another record that redefines it", target.Identifier))
+		}
+		size, err := g.calculateSize(rec)
+		if err != nil {
+			return err
+		}
+		targetSize, err := g.calculateSize(target)
+		if err != nil {
+			return err
+		}
+		if size > targetSize && rec.Level != 1 {
+			invalid(fmt.Sprintf("its %d bytes are more than the %d bytes of %s, which only level 01 records may be",
+				size, targetSize, target.Identifier))
+		}
+		if target.OccursCount > 0 {
+			invalid(fmt.Sprintf("%s has an OCCURS clause", target.Identifier))
+		}
+	}
+
+	return nil
+}
+
+// findRedefined returns the last of the records with the identifier that a REDEFINES clause refers to,
+// or nil if there is none.
+func findRedefined(records []*parse.Record, identifier string) *parse.Record {
+	for i := len(records) - 1; i >= 0; i-- {
+		if identifierKey(records[i].Identifier) == identifierKey(identifier) {
+			return records[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+func Test_checkRedefines(t *testing.T) {
+	alphanumeric := func(level int, identifier, redefines string, size int) *parse.Record {
+		return &parse.Record{
+			Level: level, Identifier: identifier, Redefines: redefines,
+			Pic: parse.Picture{PicType: parse.Alpha, PicCount: size},
+		}
+	}
+
+	tests := map[string]struct {
+		records          []*parse.Record
+		expectedWarnings []string
+	}{
+		"ValidRedefinitions": {
+			records: []*parse.Record{
+				{Level: 1, Identifier: "RECORD", Children: []*parse.Record{
+					alphanumeric(5, "A", "", 10),
+					alphanumeric(5, "B", "A", 10),
+					alphanumeric(5, "C", "a", 4),
+				}},
+			},
+		},
+		"LargerLevel01Redefinition": {
+			records: []*parse.Record{
+				alphanumeric(1, "RECORD", "", 10),
+				alphanumeric(1, "OTHER-RECORD", "RECORD", 20),
+			},
+		},
+		"DifferentLevel_Warned": {
+			records: []*parse.Record{
+				{Level: 1, Identifier: "RECORD", Children: []*parse.Record{
+					alphanumeric(5, "A", "", 10),
+					alphanumeric(7, "B", "A", 10),
+				}},
+			},
+			expectedWarnings: []string{"REDEFINES A of B is invalid, as it is at level 07 but A is at level 05"},
+		},
+		"NotImmediatelyFollowing_Warned": {
+			records: []*parse.Record{
+				{Level: 1, Identifier: "RECORD", Children: []*parse.Record{
+					alphanumeric(5, "A", "", 10),
+					alphanumeric(5, "B", "", 2),
+					alphanumeric(5, "C", "A", 10),
+				}},
+			},
+			expectedWarnings: []string{"REDEFINES A of C is invalid, as it does not immediately follow A or another record that redefines it"},
+		},
+		"LargerRedefinition_Warned": {
+			records: []*parse.Record{
+				{Level: 1, Identifier: "RECORD", Children: []*parse.Record{
+					alphanumeric(5, "A", "", 10),
+					{Level: 5, Identifier: "B", Redefines: "A", Children: []*parse.Record{
+						alphanumeric(10, "B-1", "", 8),
+						alphanumeric(10, "B-2", "", 4),
+					}},
+				}},
+			},
+			expectedWarnings: []string{"REDEFINES A of B is invalid, as its 12 bytes are more than the 10 bytes of A, which only level 01 records may be"},
+		},
+		"RedefinitionOfOccurs_Warned": {
+			records: []*parse.Record{
+				{Level: 1, Identifier: "RECORD", Children: []*parse.Record{
+					{Level: 5, Identifier: "A", OccursCount: 2, Pic: parse.Picture{PicType: parse.Alpha, PicCount: 5}},
+					alphanumeric(5, "B", "A", 10),
+				}},
+			},
+			expectedWarnings: []string{"REDEFINES A of B is invalid, as A has an OCCURS clause"},
+		},
+		"NestedRedefinition_Warned": {
+			records: []*parse.Record{
+				{Level: 1, Identifier: "RECORD", Children: []*parse.Record{
+					{Level: 5, Identifier: "GROUP", Children: []*parse.Record{
+						alphanumeric(10, "A", "", 2),
+						alphanumeric(10, "B", "A", 3),
+					}},
+				}},
+			},
+			expectedWarnings: []string{"REDEFINES A of B is invalid, as its 3 bytes are more than the 2 bytes of A, which only level 01 records may be"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var warnings []string
+			g := newGoGenerator(defaultTypeMapping(), WithWarningHandler(func(warning string) { warnings = append(warnings, warning) }))
+
+			assert.NoError(t, g.checkRedefines(tt.records))
+			assert.Equal(t, tt.expectedWarnings, warnings)
+		})
+	}
+}
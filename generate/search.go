@@ -0,0 +1,131 @@
+package generate
+
+import (
+	"fmt"
+	"go/token"
+	"slices"
+	"strings"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+// searchData represents a method that finds an element of an OCCURS table with a declared key by a
+// binary search, the same way as SEARCH ALL does.
+type searchData struct {
+	Name         string
+	FieldVarName string
+	Receiver     string
+	Params       string
+	Compare      string
+}
+
+// orderedGoTypes are the Go types that keys can be compared by with cmp.Compare.
+var orderedGoTypes = []string{
+	"string",
+	"int", "int8", "int16", "int32", "int64",
+	"uint", "uint8", "uint16", "uint32", "uint64",
+	"float32", "float64",
+}
+
+// buildSearches builds the searches of the tables with a declared key among the records of a struct.
+// A search is not built for a table whose keys cannot be found within it, whose keys do not have an
+// ordered Go type, or whose name is used by a field of the struct, which is reported as a warning.
+func (g *goGenerator) buildSearches(structVarName string, records []*parse.Record) []searchData {
+	receiver := receiverName(structVarName)
+
+	var searches []searchData
+	for _, rec := range records {
+		if len(rec.Keys) == 0 || rec.OccursCount <= 1 {
+			continue
+		}
+
+		fieldVarName := g.fieldName(rec)
+		element := fmt.Sprint(receiver, ".", fieldVarName, "[i]")
+		params := make([]string, 0, len(rec.Keys))
+		comparisons := make([]string, 0, len(rec.Keys))
+		var reason string
+		for _, key := range rec.Keys {
+			keyRec, path, ok := g.findKey(rec, key.Identifier)
+			if !ok {
+				reason = fmt.Sprint("its key ", key.Identifier, " is not found within it outside nested tables")
+				break
+			}
+			keyType := g.elementGoType(keyRec)
+			if !slices.Contains(orderedGoTypes, keyType) {
+				reason = fmt.Sprint("the Go type ", keyType, " of its key ", key.Identifier, " is not ordered")
+				break
+			}
+
+			param := toParamName(g.naming.typeName(key.Identifier), receiver)
+			params = append(params, fmt.Sprint(param, " ", keyType))
+			// The elements of a table are in ascending or descending order of a key, and the comparison
+			// must be positive for the elements before the one searched for.
+			if key.Descending {
+				comparisons = append(comparisons, fmt.Sprint("cmp.Compare(", element, path, ", ", param, ")"))
+			} else {
+				comparisons = append(comparisons, fmt.Sprint("cmp.Compare(", param, ", ", element, path, ")"))
+			}
+		}
+
+		// Unexported fields are lower case, but the search is named like a method of an exported field.
+		name := "Search" + upperFirst(fieldVarName)
+		if reason == "" && slices.ContainsFunc(records, func(r *parse.Record) bool { return g.fieldName(r) == name }) {
+			reason = fmt.Sprint(name, " is used by a field of ", structVarName)
+		}
+		if reason != "" {
+			g.warn(fmt.Sprintf("search of %s is not generated, as %s", rec.Identifier, reason))
+			continue
+		}
+
+		compare := comparisons[0]
+		if len(comparisons) > 1 {
+			compare = fmt.Sprint("cmp.Or(", strings.Join(comparisons, ", "), ")")
+		}
+		searches = append(searches, searchData{
+			Name:         name,
+			FieldVarName: fieldVarName,
+			Receiver:     receiver,
+			Params:       strings.Join(params, ", "),
+			Compare:      compare,
+		})
+	}
+
+	return searches
+}
+
+// findKey finds the record of a key within a table, which is either the table itself or a record
+// within it that is not in a nested table. It returns the path of the key from an element of the table.
+func (g *goGenerator) findKey(table *parse.Record, identifier string) (*parse.Record, string, bool) {
+	if identifierKey(table.Identifier) == identifierKey(identifier) {
+		return table, "", true
+	}
+
+	for _, child := range table.Children {
+		// A key cannot be within a nested table.
+		if child.OccursCount > 1 {
+			continue
+		}
+		if keyRec, path, ok := g.findKey(child, identifier); ok {
+			return keyRec, "." + g.fieldName(child) + path, true
+		}
+	}
+
+	return nil, "", false
+}
+
+// toParamName converts a Go name to the lower camel case used for parameter names, e.g. RateID to
+// rateID. A name that is a Go keyword or is used by the search itself gets a suffix.
+func toParamName(goName, receiver string) string {
+	param := lowerFirstWord(goName)
+	if token.IsKeyword(param) || slices.Contains([]string{receiver, "i", "cmp", "sort"}, param) {
+		param += "Key"
+	}
+	return param
+}
+
+// elementGoType returns the Go type of a single occurrence of a record.
+func (g *goGenerator) elementGoType(rec *parse.Record) string {
+	element := *rec
+	element.OccursCount = 0
+	return g.varType(&element, g.typeName(rec))
+}
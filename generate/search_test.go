@@ -0,0 +1,106 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+func Test_buildSearches(t *testing.T) {
+	rateID := &parse.Record{Level: 10, Identifier: "RATE-ID", Pic: parse.Picture{PicType: parse.Alpha, PicCount: 3}}
+	rateAmount := &parse.Record{Level: 10, Identifier: "RATE-AMOUNT", Pic: parse.Picture{PicType: parse.Decimal, PicCount: 5}}
+
+	tests := map[string]struct {
+		naming           Naming
+		rec              *parse.Record
+		expected         []searchData
+		expectedWarnings []string
+	}{
+		"TableWithoutKeys": {
+			rec: &parse.Record{Identifier: "RATES", OccursCount: 3, Children: []*parse.Record{rateID}},
+		},
+		"AscendingKey": {
+			rec: &parse.Record{Identifier: "RATES", OccursCount: 3, Keys: []parse.Key{{Identifier: "RATE-ID"}}, Children: []*parse.Record{rateID}},
+			expected: []searchData{{
+				Name:         "SearchRates",
+				FieldVarName: "Rates",
+				Receiver:     "r",
+				Params:       "rateID string",
+				Compare:      "cmp.Compare(rateID, r.Rates[i].RateID)",
+			}},
+		},
+		"DescendingKey": {
+			rec: &parse.Record{Identifier: "RATES", OccursCount: 3, Keys: []parse.Key{{Identifier: "rate-id", Descending: true}}, Children: []*parse.Record{rateID}},
+			expected: []searchData{{
+				Name:         "SearchRates",
+				FieldVarName: "Rates",
+				Receiver:     "r",
+				Params:       "rateID string",
+				Compare:      "cmp.Compare(r.Rates[i].RateID, rateID)",
+			}},
+		},
+		"UnexportedField": {
+			naming: Naming{Unexported: true},
+			rec:    &parse.Record{Identifier: "RATES", OccursCount: 3, Keys: []parse.Key{{Identifier: "RATE-ID"}}, Children: []*parse.Record{rateID}},
+			expected: []searchData{{
+				Name:         "SearchRates",
+				FieldVarName: "rates",
+				Receiver:     "r",
+				Params:       "rateID string",
+				Compare:      "cmp.Compare(rateID, r.rates[i].rateID)",
+			}},
+		},
+		"KeyNotFound_Warned": {
+			rec:              &parse.Record{Identifier: "RATES", OccursCount: 3, Keys: []parse.Key{{Identifier: "RATE-CODE"}}, Children: []*parse.Record{rateID}},
+			expectedWarnings: []string{"search of RATES is not generated, as its key RATE-CODE is not found within it outside nested tables"},
+		},
+		"KeyNotOrdered_Warned": {
+			rec:              &parse.Record{Identifier: "RATES", OccursCount: 3, Keys: []parse.Key{{Identifier: "RATE-AMOUNT"}}, Children: []*parse.Record{rateAmount}},
+			expectedWarnings: []string{"search of RATES is not generated, as the Go type decimal.Decimal of its key RATE-AMOUNT is not ordered"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var warnings []string
+			g := newGoGenerator(defaultTypeMapping(), WithNaming(tt.naming),
+				WithWarningHandler(func(warning string) { warnings = append(warnings, warning) }))
+			assert.Equal(t, tt.expected, g.buildSearches("RateTable", []*parse.Record{tt.rec}))
+			assert.Equal(t, tt.expectedWarnings, warnings)
+		})
+	}
+}
+
+func Test_buildSearches_NameUsedByField_Warned(t *testing.T) {
+	rateID := &parse.Record{Level: 10, Identifier: "RATE-ID", Pic: parse.Picture{PicType: parse.Alpha, PicCount: 3}}
+	records := []*parse.Record{
+		{Identifier: "RATES", OccursCount: 3, Keys: []parse.Key{{Identifier: "RATE-ID"}}, Children: []*parse.Record{rateID}},
+		{Identifier: "SEARCH-RATES", Pic: parse.Picture{PicType: parse.Alpha, PicCount: 1}},
+	}
+	var warnings []string
+	g := newGoGenerator(defaultTypeMapping(), WithWarningHandler(func(warning string) { warnings = append(warnings, warning) }))
+
+	assert.Empty(t, g.buildSearches("RateTable", records))
+	assert.Equal(t, []string{"search of RATES is not generated, as SearchRates is used by a field of RateTable"}, warnings)
+}
+
+func Test_toParamName(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected string
+	}{
+		"Word":               {"Codes", "codes"},
+		"TrailingInitialism": {"RateID", "rateID"},
+		"LeadingInitialism":  {"IDCode", "idCode"},
+		"Keyword":            {"Type", "typeKey"},
+		"Receiver":           {"R", "rKey"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, toParamName(tt.input, "r"))
+		})
+	}
+}
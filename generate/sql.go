@@ -0,0 +1,337 @@
+package generate
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yasv98/copybooktogo/parse"
+	"github.com/yasv98/copybooktogo/util/generic"
+)
+
+const sqlGenTemplate = `-- This file is generated by copybooktogo. DO NOT EDIT.
+{{ range .Tables }}
+-- {{ .Name }} contains a representation of {{ .Identifier }}
+CREATE TABLE "{{ .Name }}" (
+{{- range .Columns }}
+    "{{ .Name }}" {{ .Type }}{{ .Separator }}{{ if .Comment }} -- {{ .Comment }}{{ end }}
+{{- end }}
+{{- if .PrimaryKey }}
+    PRIMARY KEY ({{ .PrimaryKey }}){{ if .ParentTable }},{{ end }}
+{{- end }}
+{{- if .ParentTable }}
+    FOREIGN KEY ({{ .ParentKey }}) REFERENCES "{{ .ParentTable }}" ({{ .ParentKey }})
+{{- end }}
+);
+{{ end -}}
+`
+
+// SQLOccursMode defines how OCCURS fields are represented in SQL DDL.
+type SQLOccursMode string
+
+const (
+	// SQLOccursColumns expands each occurrence into its own set of suffixed columns.
+	SQLOccursColumns SQLOccursMode = "columns"
+	// SQLOccursTable moves the occurrences into a child table keyed by the parent key and occurrence index.
+	SQLOccursTable SQLOccursMode = "table"
+)
+
+// SQLOccursModeValues returns all supported SQLOccursMode values.
+func SQLOccursModeValues() []SQLOccursMode {
+	return []SQLOccursMode{SQLOccursColumns, SQLOccursTable}
+}
+
+// SQL type mappings may use these placeholders, which are replaced with the details of each PIC clause.
+const (
+	sqlLengthPlaceholder    = "{length}"
+	sqlPrecisionPlaceholder = "{precision}"
+	sqlScalePlaceholder     = "{scale}"
+)
+
+// maxSQLBigIntDigits is the number of digits that always fits into a BIGINT.
+const maxSQLBigIntDigits = 18
+
+// maxSQLIdentifierLength is the number of bytes of an identifier that PostgreSQL keeps, truncating the rest.
+const maxSQLIdentifierLength = 63
+
+type sqlParams struct {
+	Tables []sqlTable
+}
+
+type sqlTable struct {
+	Name        string
+	Identifier  string
+	Columns     []sqlColumn
+	PrimaryKey  string
+	ParentTable string
+	ParentKey   string
+}
+
+type sqlColumn struct {
+	Name      string
+	Type      string
+	Separator string
+	Comment   string
+	// identifier is the COBOL identifier of the field that the column holds.
+	identifier string
+}
+
+type sqlGenerator struct {
+	structs        map[string]StructData
+	picTypeMapping map[parse.PicType]string
+	occursMode     SQLOccursMode
+	tables         []sqlTable
+	// tableNames are the resolved names of the tables, which must be unique in the schema.
+	tableNames map[string]string
+	warn       func(warning string)
+}
+
+// ToSQLDDL generates SQL DDL for landing tables from a COBOL copybook AST.
+//
+// Each level 01 group becomes a table whose columns are the flattened elementary fields, named after
+// their path through the group hierarchy. OCCURS are expanded depending on the given occursMode. The
+// names are quoted, so that COBOL names such as ORDER or GROUP do not clash with SQL keywords. Names
+// longer than the 63 bytes that PostgreSQL keeps are shortened with a hash of the full name, and names
+// that are already used in the schema or table, such as those of A-B and A:B, are numbered. Both are
+// reported as warnings.
+func ToSQLDDL(ast []*parse.Record, copybookName string, typeOverrides map[parse.PicType]string, occursMode SQLOccursMode, opts ...Option) ([]byte, error) {
+	if len(ast) == 0 {
+		return nil, fmt.Errorf("ast is empty")
+	}
+	if !slices.Contains(SQLOccursModeValues(), occursMode) {
+		return nil, fmt.Errorf("%q must be a valid SQL occurs mode: %v", occursMode, SQLOccursModeValues())
+	}
+
+	goGen := newGoGenerator(defaultTypeMapping(), opts...)
+	structs, err := goGen.buildCopybookStructData(copybookName, ast)
+	if err != nil {
+		return nil, err
+	}
+	structs = withoutSlack(structs)
+
+	sqlGen := sqlGenerator{
+		structs: make(map[string]StructData, len(structs)),
+		// Merge default PIC type mappings with any configured overrides.
+		picTypeMapping: generic.MergeMaps(defaultSQLTypeMapping(), typeOverrides),
+		occursMode:     occursMode,
+		tableNames:     make(map[string]string),
+		warn:           goGen.warn,
+	}
+	for _, s := range structs {
+		sqlGen.structs[s.StructVarName] = s
+	}
+
+	// Level 01 groups each become a table, while any elementary level 01 records share a table named
+	// after the copybook.
+	var elementaryRecords []FieldData
+	for _, rec := range structs[0].Fields {
+		if rec.StructVarName == "" {
+			elementaryRecords = append(elementaryRecords, rec)
+			continue
+		}
+		sqlGen.addTable(toSnakeIdentifier(rec.Identifier), rec.Identifier, sqlGen.structs[rec.StructVarName].Fields, sqlParentTable{})
+	}
+	if len(elementaryRecords) > 0 {
+		sqlGen.addTable(toSnakeIdentifier(structs[0].Identifier), structs[0].Identifier, elementaryRecords, sqlParentTable{})
+	}
+
+	return executeTemplate(sqlGenTemplate, sqlParams{Tables: sqlGen.tables})
+}
+
+// sqlParentTable identifies the table that a child table created for OCCURS in table mode belongs to.
+type sqlParentTable struct {
+	name            string
+	key             []sqlColumn
+	occurrenceIndex sqlColumn
+}
+
+// addTable adds a table holding the flattened fields. Child tables created for OCCURS in table mode
+// are keyed by the key of their parent table followed by the occurrence index.
+func (g *sqlGenerator) addTable(name, identifier string, fields []FieldData, parent sqlParentTable) {
+	name = g.resolveSQLName("table", name, identifier, "the schema", g.tableNames)
+	table := sqlTable{Name: name, Identifier: identifier, ParentTable: parent.name}
+
+	// The key is resolved first, so that the columns of child tables reference its resolved names.
+	columnNames := make(map[string]string)
+	var key []sqlColumn
+	if g.occursMode == SQLOccursTable {
+		key = []sqlColumn{{Name: "record_id", Type: "BIGINT NOT NULL", identifier: "the record ID"}}
+		if parent.name != "" {
+			key = append(slices.Clone(parent.key), parent.occurrenceIndex)
+		}
+		for i := range key {
+			key[i].Name = g.resolveSQLName("column", key[i].Name, key[i].identifier, identifier, columnNames)
+		}
+		table.PrimaryKey = joinColumnNames(key)
+		table.ParentKey = joinColumnNames(parent.key)
+		table.Columns = slices.Clone(key)
+	}
+
+	// Child tables are added after their parent so that foreign keys reference existing tables.
+	tableIndex := len(g.tables)
+	g.tables = append(g.tables, table)
+	columns := g.flattenFields("", fields, name, key)
+	for i := range columns {
+		columns[i].Name = g.resolveSQLName("column", columns[i].Name, columns[i].identifier, identifier, columnNames)
+	}
+	table.Columns = append(table.Columns, columns...)
+
+	for i := range table.Columns {
+		if i < len(table.Columns)-1 || table.PrimaryKey != "" {
+			table.Columns[i].Separator = ","
+		}
+	}
+	g.tables[tableIndex] = table
+}
+
+func (g *sqlGenerator) flattenFields(prefix string, fields []FieldData, tableName string, key []sqlColumn) []sqlColumn {
+	var columns []sqlColumn
+	for _, field := range fields {
+		name := prefix + toSnakeIdentifier(field.Identifier)
+
+		if field.OccursCount > 1 && g.occursMode == SQLOccursTable {
+			indexColumn := sqlColumn{Name: toSnakeIdentifier(field.Identifier) + "_idx", Type: "INTEGER NOT NULL", identifier: field.Identifier}
+			elementFields := []FieldData{field}
+			if field.StructVarName != "" {
+				elementFields = g.structs[field.StructVarName].Fields
+			} else {
+				elementFields[0].OccursCount = 0
+				elementFields[0].PicSize /= field.OccursCount
+			}
+			g.addTable(tableName+"_"+name, field.Identifier, elementFields, sqlParentTable{tableName, key, indexColumn})
+			continue
+		}
+
+		occurrences := []string{name}
+		if field.OccursCount > 1 {
+			occurrences = make([]string, field.OccursCount)
+			for i := range occurrences {
+				occurrences[i] = name + "_" + strconv.Itoa(i+1)
+			}
+		}
+
+		for _, occurrence := range occurrences {
+			if field.StructVarName != "" {
+				columns = append(columns, g.flattenFields(occurrence+"__", g.structs[field.StructVarName].Fields, tableName, key)...)
+				continue
+			}
+			if field.Usage.IsOpaque() {
+				// BYTEA has no length, so the comment gives the number of bytes.
+				columns = append(columns, sqlColumn{
+					Name: occurrence,
+					Type: "BYTEA",
+					Comment: fmt.Sprint(field.Identifier, " USAGE ", strings.ToUpper(field.Usage.String()), " (",
+						field.PicSize/max(1, field.OccursCount), " bytes)"),
+					identifier: field.Identifier,
+				})
+				continue
+			}
+			if floatType, ok := sqlFloatTypes[field.Usage]; ok {
+				columns = append(columns, sqlColumn{
+					Name:       occurrence,
+					Type:       floatType,
+					Comment:    fmt.Sprint(field.Identifier, " USAGE ", strings.ToUpper(field.Usage.String())),
+					identifier: field.Identifier,
+				})
+				continue
+			}
+			columns = append(columns, sqlColumn{
+				Name:       occurrence,
+				Type:       g.columnType(field.Pic),
+				Comment:    fmt.Sprint(field.Identifier, " PIC ", field.Pic.PicString),
+				identifier: field.Identifier,
+			})
+		}
+	}
+
+	return columns
+}
+
+// resolveSQLName resolves the name of a table in the schema or of a column in its table. A name longer than
+// PostgreSQL keeps is shortened with a hash of the full name, so that it stays unique and stable, and a name
+// that is already used is numbered.
+func (g *sqlGenerator) resolveSQLName(kind, name, identifier, parentIdentifier string, usedNames map[string]string) string {
+	shortened := shortenSQLIdentifier(name)
+	if shortened != name {
+		g.warn(fmt.Sprintf("SQL %s %s of %s shortened from %s to %s, as PostgreSQL keeps %d bytes of a name", kind,
+			identifier, parentIdentifier, name, shortened, maxSQLIdentifierLength))
+	}
+
+	resolved := shortened
+	for i := 2; usedNames[resolved] != ""; i++ {
+		resolved = shortenSQLIdentifier(fmt.Sprint(name, "_", i))
+	}
+	if resolved != shortened {
+		g.warn(fmt.Sprintf("SQL %s %s of %s renamed from %s to %s, as %s", kind, identifier, parentIdentifier,
+			shortened, resolved, collisionReason(shortened, usedNames)))
+	}
+	usedNames[resolved] = identifier
+	return resolved
+}
+
+// shortenSQLIdentifier shortens an identifier to the length that PostgreSQL keeps, replacing its end with a
+// hash of the whole identifier.
+func shortenSQLIdentifier(name string) string {
+	if len(name) <= maxSQLIdentifierLength {
+		return name
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(name))
+	suffix := fmt.Sprintf("_%08x", hash.Sum32())
+	return name[:maxSQLIdentifierLength-len(suffix)] + suffix
+}
+
+// joinColumnNames returns the names of columns as a list of quoted identifiers.
+func joinColumnNames(columns []sqlColumn) string {
+	return strings.Join(generic.Map(func(c sqlColumn) string { return `"` + c.Name + `"` }, columns), ", ")
+}
+
+func (g *sqlGenerator) columnType(pic parse.Picture) string {
+	sqlType, ok := g.picTypeMapping[pic.PicType]
+	if !ok {
+		// Default to a fixed width character column if no mapping is found.
+		sqlType = "CHAR(" + sqlLengthPlaceholder + ")"
+	}
+
+	// Integers that could overflow a BIGINT are widened to a NUMERIC with no fractional digits.
+	if sqlType == "BIGINT" && pic.Precision() > maxSQLBigIntDigits {
+		sqlType = "NUMERIC(" + sqlPrecisionPlaceholder + ",0)"
+	}
+
+	// The length of character columns is counted in characters rather than bytes.
+	length := pic.PicCount
+	if pic.PicType == parse.National || pic.PicType == parse.DBCS {
+		length /= parse.DoubleByteCharacterWidth
+	}
+
+	return strings.NewReplacer(
+		sqlLengthPlaceholder, strconv.Itoa(length),
+		sqlPrecisionPlaceholder, strconv.Itoa(pic.Precision()),
+		sqlScalePlaceholder, strconv.Itoa(pic.Scale()),
+	).Replace(sqlType)
+}
+
+// sqlFloatTypes maps the floating-point usages, which have no PIC clause, to SQL column types.
+var sqlFloatTypes = map[parse.Usage]string{
+	parse.Float:  "REAL",
+	parse.Double: "DOUBLE PRECISION",
+}
+
+// defaultSQLTypeMapping returns the PostgreSQL column types of the PIC types. VARCHAR lengths are counted in
+// characters, so national and DBCS fields need no other type.
+func defaultSQLTypeMapping() map[parse.PicType]string {
+	return map[parse.PicType]string{
+		parse.Unsigned:      "BIGINT",
+		parse.Signed:        "BIGINT",
+		parse.Decimal:       "NUMERIC(" + sqlPrecisionPlaceholder + "," + sqlScalePlaceholder + ")",
+		parse.Alpha:         "VARCHAR(" + sqlLengthPlaceholder + ")",
+		parse.NumericEdited: "CHAR(" + sqlLengthPlaceholder + ")",
+		parse.AlphaEdited:   "CHAR(" + sqlLengthPlaceholder + ")",
+		parse.National:      "VARCHAR(" + sqlLengthPlaceholder + ")",
+		parse.DBCS:          "VARCHAR(" + sqlLengthPlaceholder + ")",
+		parse.Unknown:       "CHAR(" + sqlLengthPlaceholder + ")",
+	}
+}
@@ -0,0 +1,331 @@
+package generate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+func Test_ToSQLDDL(t *testing.T) {
+	input := []*parse.Record{
+		{
+			Level:      1,
+			Identifier: "RECORD-1",
+			Children: []*parse.Record{
+				{
+					Level:      5,
+					Identifier: "RECORD-2",
+					Pic:        parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2},
+				},
+				{
+					Level:       5,
+					Identifier:  "RECORD-3",
+					OccursCount: 2,
+					Children: []*parse.Record{
+						{
+							Level:      7,
+							Identifier: "RECORD-4",
+							Pic:        parse.Picture{PicString: "S9(05)V99", PicType: parse.Decimal, PicCount: 8, IntegerDigits: 5, FractionDigits: 2, Signed: true},
+						},
+						{
+							Level:       7,
+							Identifier:  "RECORD-5",
+							OccursCount: 2,
+							Pic:         parse.Picture{PicString: "9(19)", PicType: parse.Unsigned, PicCount: 19, IntegerDigits: 19},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		input         []*parse.Record
+		typeOverrides map[parse.PicType]string
+		occursMode    SQLOccursMode
+		expected      string
+		assertError   assert.ErrorAssertionFunc
+	}{
+		"Valid_CopybookWithOccursColumns_ReturnsSuffixedColumns": {
+			input:         input,
+			typeOverrides: map[parse.PicType]string{},
+			occursMode:    SQLOccursColumns,
+			expected: `-- This file is generated by copybooktogo. DO NOT EDIT.
+
+-- record_1 contains a representation of RECORD-1
+CREATE TABLE "record_1" (
+    "record_2" VARCHAR(2), -- RECORD-2 PIC X(02)
+    "record_3_1__record_4" NUMERIC(7,2), -- RECORD-4 PIC S9(05)V99
+    "record_3_1__record_5_1" NUMERIC(19,0), -- RECORD-5 PIC 9(19)
+    "record_3_1__record_5_2" NUMERIC(19,0), -- RECORD-5 PIC 9(19)
+    "record_3_2__record_4" NUMERIC(7,2), -- RECORD-4 PIC S9(05)V99
+    "record_3_2__record_5_1" NUMERIC(19,0), -- RECORD-5 PIC 9(19)
+    "record_3_2__record_5_2" NUMERIC(19,0) -- RECORD-5 PIC 9(19)
+);
+`,
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithOccursTable_ReturnsChildTables": {
+			input:         input,
+			typeOverrides: map[parse.PicType]string{},
+			occursMode:    SQLOccursTable,
+			expected: `-- This file is generated by copybooktogo. DO NOT EDIT.
+
+-- record_1 contains a representation of RECORD-1
+CREATE TABLE "record_1" (
+    "record_id" BIGINT NOT NULL,
+    "record_2" VARCHAR(2), -- RECORD-2 PIC X(02)
+    PRIMARY KEY ("record_id")
+);
+
+-- record_1_record_3 contains a representation of RECORD-3
+CREATE TABLE "record_1_record_3" (
+    "record_id" BIGINT NOT NULL,
+    "record_3_idx" INTEGER NOT NULL,
+    "record_4" NUMERIC(7,2), -- RECORD-4 PIC S9(05)V99
+    PRIMARY KEY ("record_id", "record_3_idx"),
+    FOREIGN KEY ("record_id") REFERENCES "record_1" ("record_id")
+);
+
+-- record_1_record_3_record_5 contains a representation of RECORD-5
+CREATE TABLE "record_1_record_3_record_5" (
+    "record_id" BIGINT NOT NULL,
+    "record_3_idx" INTEGER NOT NULL,
+    "record_5_idx" INTEGER NOT NULL,
+    "record_5" NUMERIC(19,0), -- RECORD-5 PIC 9(19)
+    PRIMARY KEY ("record_id", "record_3_idx", "record_5_idx"),
+    FOREIGN KEY ("record_id", "record_3_idx") REFERENCES "record_1_record_3" ("record_id", "record_3_idx")
+);
+`,
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithTypeOverrides_ReturnsColumnsWithTypesOverridden": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "RECORD",
+					Pic:        parse.Picture{PicString: "X(10)", PicType: parse.Alpha, PicCount: 10},
+				},
+				{
+					Level:      1,
+					Identifier: "NATIONAL-RECORD",
+					Pic:        parse.Picture{PicString: "N(10)", PicType: parse.National, PicCount: 20},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{
+				parse.Alpha: "CHAR({length})",
+			},
+			occursMode: SQLOccursColumns,
+			expected: `-- This file is generated by copybooktogo. DO NOT EDIT.
+
+-- _1copybook contains a representation of 1COPYBOOK
+CREATE TABLE "_1copybook" (
+    "record" CHAR(10), -- RECORD PIC X(10)
+    "national_record" VARCHAR(10) -- NATIONAL-RECORD PIC N(10)
+);
+`,
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithPointer_ReturnsByteaColumn": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "RECORD",
+					Children: []*parse.Record{
+						{Level: 5, Identifier: "POINTER-1", Usage: parse.Pointer, OccursCount: 2},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			occursMode:    SQLOccursColumns,
+			expected: `-- This file is generated by copybooktogo. DO NOT EDIT.
+
+-- record contains a representation of RECORD
+CREATE TABLE "record" (
+    "pointer_1_1" BYTEA, -- POINTER-1 USAGE POINTER (4 bytes)
+    "pointer_1_2" BYTEA -- POINTER-1 USAGE POINTER (4 bytes)
+);
+`,
+			assertError: assert.NoError,
+		},
+		"Valid_CopybookWithKeywordNames_ReturnsQuotedIdentifiers": {
+			input: []*parse.Record{
+				{
+					Level:      1,
+					Identifier: "ORDER",
+					Children: []*parse.Record{
+						{Level: 5, Identifier: "GROUP", Pic: parse.Picture{PicString: "X(01)", PicType: parse.Alpha, PicCount: 1}},
+						{Level: 5, Identifier: "DESC", Pic: parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2}},
+					},
+				},
+			},
+			typeOverrides: map[parse.PicType]string{},
+			occursMode:    SQLOccursColumns,
+			expected: `-- This file is generated by copybooktogo. DO NOT EDIT.
+
+-- order contains a representation of ORDER
+CREATE TABLE "order" (
+    "group" VARCHAR(1), -- GROUP PIC X(01)
+    "desc" VARCHAR(2) -- DESC PIC X(02)
+);
+`,
+			assertError: assert.NoError,
+		},
+		"Invalid_OccursMode_ReturnsError": {
+			input:         input,
+			typeOverrides: map[parse.PicType]string{},
+			occursMode:    "invalid",
+			assertError:   assert.Error,
+		},
+		"Invalid_EmptyCopybook_ReturnsError": {
+			input:         []*parse.Record{},
+			typeOverrides: map[parse.PicType]string{},
+			occursMode:    SQLOccursColumns,
+			assertError:   assert.Error,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ToSQLDDL(tt.input, "1COPYBOOK", tt.typeOverrides, tt.occursMode)
+			tt.assertError(t, err)
+			assert.Equal(t, tt.expected, string(got))
+		})
+	}
+}
+
+func Test_ToSQLDDL_DuplicateNames_Renamed(t *testing.T) {
+	alpha := parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2}
+	input := []*parse.Record{
+		{
+			Level:      1,
+			Identifier: "ORDER",
+			Children: []*parse.Record{
+				{Level: 5, Identifier: "A-B", Pic: alpha},
+				{Level: 5, Identifier: "A:B", Pic: alpha},
+				{Level: 5, Identifier: "ITEM", OccursCount: 2, Children: []*parse.Record{
+					{Level: 10, Identifier: "LINE", OccursCount: 2, Children: []*parse.Record{
+						{Level: 15, Identifier: "ITEM", OccursCount: 2, Pic: alpha},
+					}},
+				}},
+			},
+		},
+	}
+	expected := `-- This file is generated by copybooktogo. DO NOT EDIT.
+
+-- order contains a representation of ORDER
+CREATE TABLE "order" (
+    "record_id" BIGINT NOT NULL,
+    "a_b" VARCHAR(2), -- A-B PIC X(02)
+    "a_b_2" VARCHAR(2), -- A:B PIC X(02)
+    PRIMARY KEY ("record_id")
+);
+
+-- order_item contains a representation of ITEM
+CREATE TABLE "order_item" (
+    "record_id" BIGINT NOT NULL,
+    "item_idx" INTEGER NOT NULL,
+    PRIMARY KEY ("record_id", "item_idx"),
+    FOREIGN KEY ("record_id") REFERENCES "order" ("record_id")
+);
+
+-- order_item_line contains a representation of LINE
+CREATE TABLE "order_item_line" (
+    "record_id" BIGINT NOT NULL,
+    "item_idx" INTEGER NOT NULL,
+    "line_idx" INTEGER NOT NULL,
+    PRIMARY KEY ("record_id", "item_idx", "line_idx"),
+    FOREIGN KEY ("record_id", "item_idx") REFERENCES "order_item" ("record_id", "item_idx")
+);
+
+-- order_item_line_item contains a representation of ITEM
+CREATE TABLE "order_item_line_item" (
+    "record_id" BIGINT NOT NULL,
+    "item_idx" INTEGER NOT NULL,
+    "line_idx" INTEGER NOT NULL,
+    "item_idx_2" INTEGER NOT NULL,
+    "item" VARCHAR(2), -- ITEM PIC X(02)
+    PRIMARY KEY ("record_id", "item_idx", "line_idx", "item_idx_2"),
+    FOREIGN KEY ("record_id", "item_idx", "line_idx") REFERENCES "order_item_line" ("record_id", "item_idx", "line_idx")
+);
+`
+	var warnings []string
+
+	got, err := ToSQLDDL(input, "COPYBOOK", map[parse.PicType]string{}, SQLOccursTable,
+		WithWarningHandler(func(warning string) { warnings = append(warnings, warning) }))
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(got))
+	assert.Equal(t, []string{
+		"field A:B of ORDER renamed from AB to AB2, as AB is already used by A-B",
+		"SQL column ITEM of ITEM renamed from item_idx to item_idx_2, as item_idx is already used by ITEM",
+		"SQL column A:B of ORDER renamed from a_b to a_b_2, as a_b is already used by A-B",
+	}, warnings)
+}
+
+func Test_ToSQLDDL_LongNames_Shortened(t *testing.T) {
+	alpha := parse.Picture{PicString: "X(10)", PicType: parse.Alpha, PicCount: 10}
+	input := []*parse.Record{
+		{
+			Level:      1,
+			Identifier: "ACCOUNT",
+			Children: []*parse.Record{
+				{Level: 5, Identifier: "CUSTOMER-ACCOUNT-INFORMATION", Children: []*parse.Record{
+					{Level: 10, Identifier: "PRIMARY-CONTACT-DETAILS-GROUP", Children: []*parse.Record{
+						{Level: 15, Identifier: "CONTACT-TELEPHONE-NUMBER-MAIN", Pic: alpha},
+						{Level: 15, Identifier: "CONTACT-TELEPHONE-NUMBER-ALT1", Pic: alpha},
+					}},
+				}},
+			},
+		},
+	}
+	expected := `-- This file is generated by copybooktogo. DO NOT EDIT.
+
+-- account contains a representation of ACCOUNT
+CREATE TABLE "account" (
+    "customer_account_information__primary_contact_details__bc6b8663" VARCHAR(10), -- CONTACT-TELEPHONE-NUMBER-MAIN PIC X(10)
+    "customer_account_information__primary_contact_details__d0bd8768" VARCHAR(10) -- CONTACT-TELEPHONE-NUMBER-ALT1 PIC X(10)
+);
+`
+	var warnings []string
+
+	got, err := ToSQLDDL(input, "COPYBOOK", map[parse.PicType]string{}, SQLOccursColumns,
+		WithWarningHandler(func(warning string) { warnings = append(warnings, warning) }))
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(got))
+	assert.Equal(t, []string{
+		"SQL column CONTACT-TELEPHONE-NUMBER-MAIN of ACCOUNT shortened from " +
+			"customer_account_information__primary_contact_details_group__contact_telephone_number_main to " +
+			"customer_account_information__primary_contact_details__bc6b8663, as PostgreSQL keeps 63 bytes of a name",
+		"SQL column CONTACT-TELEPHONE-NUMBER-ALT1 of ACCOUNT shortened from " +
+			"customer_account_information__primary_contact_details_group__contact_telephone_number_alt1 to " +
+			"customer_account_information__primary_contact_details__d0bd8768, as PostgreSQL keeps 63 bytes of a name",
+	}, warnings)
+}
+
+func Test_shortenSQLIdentifier(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected string
+	}{
+		"AtLimit_Kept": {
+			input:    strings.Repeat("a", 63),
+			expected: strings.Repeat("a", 63),
+		},
+		"OverLimit_ShortenedWithHash": {
+			input:    strings.Repeat("a", 64),
+			expected: strings.Repeat("a", 54) + "_d96f0f85",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := shortenSQLIdentifier(tt.input)
+			assert.Equal(t, tt.expected, got)
+			assert.LessOrEqual(t, len(got), maxSQLIdentifierLength)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package generate
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yasv98/copybooktogo/util/generic"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+// Dimension is a dimension of an OCCURS table, from the outermost table a field is nested in to the
+// OCCURS clause of the field itself.
+type Dimension struct {
+	Count int
+	// Stride is the number of bytes between the starts of consecutive occurrences.
+	Stride int
+}
+
+// accessorData represents a method that returns the byte offsets of an element of a field in a
+// multi-dimensional table.
+type accessorData struct {
+	Name         string
+	FieldVarName string
+	Params       string
+	Offset       string
+	Size         int
+}
+
+// indexNames are the parameter names of accessors, one for each of the up to seven dimensions that an
+// IBM COBOL table can have. The dimensions of deeper tables, which other compilers allow, are numbered.
+var indexNames = []string{"i", "j", "k", "l", "m", "n", "o"}
+
+// indexName returns the parameter name of an accessor for the dimension of a table at an index.
+func indexName(dimension int) string {
+	if dimension < len(indexNames) {
+		return indexNames[dimension]
+	}
+	return fmt.Sprint("i", dimension+1)
+}
+
+// enterTable adds the dimension of an OCCURS group to the dimensions of the records within it.
+func (g *goGenerator) enterTable(rec *parse.Record) error {
+	if rec.OccursCount > 1 {
+		size, err := g.calculateSize(rec)
+		if err != nil {
+			return err
+		}
+		g.tables = append(g.tables, Dimension{Count: rec.OccursCount, Stride: size / rec.OccursCount})
+	}
+
+	return nil
+}
+
+// leaveTable removes the dimension added by enterTable once the records within the group are built.
+func (g *goGenerator) leaveTable(rec *parse.Record) {
+	if rec.OccursCount > 1 {
+		g.tables = g.tables[:len(g.tables)-1]
+	}
+}
+
+// tableDimensions returns the dimensions of the table a record is part of, which are only returned
+// for multi-dimensional tables as single OCCURS are fully described by the occurrence count.
+func (g *goGenerator) tableDimensions(rec *parse.Record, size int) []Dimension {
+	dimensions := slices.Clone(g.tables)
+	if rec.OccursCount > 1 {
+		dimensions = append(dimensions, Dimension{Count: rec.OccursCount, Stride: size / rec.OccursCount})
+	}
+	if len(dimensions) < 2 {
+		return nil
+	}
+
+	return dimensions
+}
+
+func getDimensionsTag(dimensions []Dimension) string {
+	if len(dimensions) == 0 {
+		return ""
+	}
+
+	counts := generic.Map(func(d Dimension) string { return fmt.Sprint(d.Count) }, dimensions)
+	strides := generic.Map(func(d Dimension) string { return fmt.Sprint(d.Stride) }, dimensions)
+	return fmt.Sprint(",dims=", strings.Join(counts, ";"), ",strides=", strings.Join(strides, ";"))
+}
+
+// buildAccessors builds the accessors of the fields of a struct that are part of a multi-dimensional
+// table. The offsets are global, like the start and end positions of the fields. An accessor whose name
+// is used by a field of the struct is reported as a warning.
+func (g *goGenerator) buildAccessors(structVarName string, fields []FieldData) []accessorData {
+	var accessors []accessorData
+	for _, field := range fields {
+		if len(field.Dimensions) == 0 {
+			continue
+		}
+
+		// Unexported fields are lower case, but the accessor is named like a method of an exported field.
+		name := upperFirst(field.FieldVarName) + "At"
+		if slices.ContainsFunc(fields, func(f FieldData) bool { return f.FieldVarName == name }) {
+			g.warn(fmt.Sprintf("accessor of %s is not generated, as %s is used by a field of %s", field.Identifier,
+				name, structVarName))
+			continue
+		}
+
+		indexes := make([]string, len(field.Dimensions))
+		offset := fmt.Sprint(field.PicGlobalStart - 1)
+		for i, dimension := range field.Dimensions {
+			indexes[i] = indexName(i)
+			offset += fmt.Sprint(" + ", indexes[i], "*", dimension.Stride)
+		}
+
+		accessors = append(accessors, accessorData{
+			Name:         name,
+			FieldVarName: field.FieldVarName,
+			Params:       strings.Join(indexes, ", "),
+			Offset:       offset,
+			Size:         field.PicSize / max(1, field.OccursCount),
+		})
+	}
+
+	return accessors
+}
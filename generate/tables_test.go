@@ -0,0 +1,114 @@
+package generate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+func Test_tableDimensions(t *testing.T) {
+	tests := map[string]struct {
+		tables   []Dimension
+		rec      *parse.Record
+		size     int
+		expected []Dimension
+	}{
+		"NotInTable": {
+			rec:  &parse.Record{Pic: parse.Picture{PicCount: 3}},
+			size: 3,
+		},
+		"SingleOccurs": {
+			rec:  &parse.Record{Pic: parse.Picture{PicCount: 3}, OccursCount: 4},
+			size: 12,
+		},
+		"ScalarInSingleTable": {
+			tables: []Dimension{{Count: 2, Stride: 5}},
+			rec:    &parse.Record{Pic: parse.Picture{PicCount: 3}},
+			size:   3,
+		},
+		"OccursInTable": {
+			tables:   []Dimension{{Count: 2, Stride: 13}},
+			rec:      &parse.Record{Pic: parse.Picture{PicCount: 3}, OccursCount: 4},
+			size:     12,
+			expected: []Dimension{{Count: 2, Stride: 13}, {Count: 4, Stride: 3}},
+		},
+		"ScalarInNestedTables": {
+			tables:   []Dimension{{Count: 2, Stride: 37}, {Count: 3, Stride: 12}},
+			rec:      &parse.Record{Pic: parse.Picture{PicCount: 3}},
+			size:     3,
+			expected: []Dimension{{Count: 2, Stride: 37}, {Count: 3, Stride: 12}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newGoGenerator(defaultTypeMapping())
+			g.tables = tt.tables
+			assert.Equal(t, tt.expected, g.tableDimensions(tt.rec, tt.size))
+			assert.Equal(t, tt.tables, g.tables)
+		})
+	}
+}
+
+func Test_buildAccessors(t *testing.T) {
+	fields := []FieldData{
+		{FieldVarName: "Header", PicSize: 2, PicGlobalStart: 1},
+		{
+			FieldVarName:   "Rate",
+			PicSize:        12,
+			PicGlobalStart: 4,
+			OccursCount:    4,
+			Dimensions:     []Dimension{{Count: 2, Stride: 37}, {Count: 3, Stride: 12}, {Count: 4, Stride: 3}},
+		},
+	}
+
+	expected := []accessorData{
+		{
+			Name:         "RateAt",
+			FieldVarName: "Rate",
+			Params:       "i, j, k",
+			Offset:       "3 + i*37 + j*12 + k*3",
+			Size:         3,
+		},
+	}
+	assert.Equal(t, expected, newGoGenerator(defaultTypeMapping()).buildAccessors("Rates", fields))
+}
+
+func Test_buildAccessors_MoreThanSevenDimensions(t *testing.T) {
+	// Eight nested OCCURS 2 groups around a PIC X field.
+	rec := &parse.Record{Level: 40, Identifier: "CELL", OccursCount: 2, Pic: parse.Picture{PicString: "X", PicType: parse.Alpha, PicCount: 1}}
+	for level := 35; level > 0; level -= 5 {
+		rec = &parse.Record{Level: level, Identifier: fmt.Sprint("DIM-", level), OccursCount: 2, Children: []*parse.Record{rec}}
+	}
+	ast := []*parse.Record{{Level: 1, Identifier: "CUBE", Children: []*parse.Record{rec}}}
+
+	got, err := ToGoStructsData(ast, "Copybook", "main", nil)
+	require.NoError(t, err)
+	assert.Contains(t, string(got), "func (Dim35) CellAt(i, j, k, l, m, n, o, i8 int) (start, end int) {\n"+
+		"\tstart = 0 + i*128 + j*64 + k*32 + l*16 + m*8 + n*4 + o*2 + i8*1\n")
+}
+
+func Test_buildAccessors_UnexportedField(t *testing.T) {
+	fields := []FieldData{
+		{FieldVarName: "r2", PicSize: 12, PicGlobalStart: 1, OccursCount: 4, Dimensions: []Dimension{{Count: 2, Stride: 12}, {Count: 4, Stride: 3}}},
+	}
+
+	expected := []accessorData{{Name: "R2At", FieldVarName: "r2", Params: "i, j", Offset: "0 + i*12 + j*3", Size: 3}}
+	assert.Equal(t, expected, newGoGenerator(defaultTypeMapping()).buildAccessors("Rates", fields))
+}
+
+func Test_buildAccessors_NameUsedByField_Warned(t *testing.T) {
+	fields := []FieldData{
+		{FieldVarName: "Item", Identifier: "ITEM", PicSize: 12, OccursCount: 4, Dimensions: []Dimension{{Count: 2, Stride: 12}, {Count: 4, Stride: 3}}},
+		{FieldVarName: "ItemAt", Identifier: "ITEM-AT", PicSize: 2},
+	}
+	var warnings []string
+	g := newGoGenerator(defaultTypeMapping(), WithWarningHandler(func(warning string) { warnings = append(warnings, warning) }))
+
+	assert.Empty(t, g.buildAccessors("Items", fields))
+	assert.Equal(t, []string{"accessor of ITEM is not generated, as ItemAt is used by a field of Items"}, warnings)
+}
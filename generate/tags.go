@@ -0,0 +1,86 @@
+package generate
+
+import (
+	"strings"
+
+	"github.com/kenshaw/snaker"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+// TagCase defines the naming convention of the values of a struct tag.
+type TagCase string
+
+const (
+	// TagSnake names fields in lower snake case after their Go name, e.g. cust_id.
+	TagSnake TagCase = "snake"
+	// TagCamel names fields in lower camel case after their Go name, e.g. custID.
+	TagCamel TagCase = "camel"
+	// TagCOBOL names fields by their original COBOL identifier, e.g. WS-CUST-ID.
+	TagCOBOL TagCase = "cobol"
+)
+
+// TagCaseValues returns all supported TagCase values.
+func TagCaseValues() []TagCase {
+	return []TagCase{TagSnake, TagCamel, TagCOBOL}
+}
+
+// OmitEmpty defines which fields get the omitempty option of a struct tag.
+type OmitEmpty string
+
+const (
+	// OmitEmptyNever gives no field the omitempty option.
+	OmitEmptyNever OmitEmpty = "never"
+	// OmitEmptyAlways gives every field the omitempty option.
+	OmitEmptyAlways OmitEmpty = "always"
+	// OmitEmptyRedefines gives the fields that redefine another field the omitempty option, as only one of
+	// the fields sharing the same storage is usually set.
+	OmitEmptyRedefines OmitEmpty = "redefines"
+)
+
+// OmitEmptyValues returns all supported OmitEmpty values.
+func OmitEmptyValues() []OmitEmpty {
+	return []OmitEmpty{OmitEmptyNever, OmitEmptyAlways, OmitEmptyRedefines}
+}
+
+// Tag is a struct tag that the generated fields get in addition to the pic tag, such as json or db.
+type Tag struct {
+	Key       string
+	Case      TagCase
+	OmitEmpty OmitEmpty
+}
+
+// tagData represents a struct tag of a field, e.g. json:"cust_id,omitempty".
+type tagData struct {
+	Key   string
+	Value string
+}
+
+// buildTags builds the additional struct tags of a field, in the order in which they are configured.
+func buildTags(tags []Tag, rec *parse.Record, fieldVarName string) []tagData {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	// The suffix that a field named after a Go keyword gets is not part of its tag values.
+	goName := strings.TrimSuffix(fieldVarName, "_")
+	data := make([]tagData, 0, len(tags))
+	for _, tag := range tags {
+		var value string
+		switch tag.Case {
+		case TagCamel:
+			value = lowerFirstWord(goName)
+		case TagCOBOL:
+			value = rec.Identifier
+		default:
+			value = snaker.CamelToSnake(goName)
+		}
+
+		if tag.OmitEmpty == OmitEmptyAlways || (tag.OmitEmpty == OmitEmptyRedefines && rec.Redefines != "") {
+			value += ",omitempty"
+		}
+		data = append(data, tagData{Key: tag.Key, Value: value})
+	}
+
+	return data
+}
@@ -0,0 +1,67 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+func Test_buildTags(t *testing.T) {
+	tests := map[string]struct {
+		tags         []Tag
+		rec          *parse.Record
+		fieldVarName string
+		expected     []tagData
+	}{
+		"NoTags": {
+			rec:          &parse.Record{Identifier: "WS-CUST-ID"},
+			fieldVarName: "CustID",
+		},
+		"NamingConventions": {
+			tags: []Tag{
+				{Key: "json", Case: TagSnake},
+				{Key: "xml", Case: TagCamel},
+				{Key: "db", Case: TagCOBOL},
+			},
+			rec:          &parse.Record{Identifier: "WS-CUST-ID"},
+			fieldVarName: "CustID",
+			expected: []tagData{
+				{Key: "json", Value: "cust_id"},
+				{Key: "xml", Value: "custID"},
+				{Key: "db", Value: "WS-CUST-ID"},
+			},
+		},
+		"UnexportedKeywordField": {
+			tags:         []Tag{{Key: "json", Case: TagSnake}, {Key: "xml", Case: TagCamel}},
+			rec:          &parse.Record{Identifier: "TYPE"},
+			fieldVarName: "type_",
+			expected:     []tagData{{Key: "json", Value: "type"}, {Key: "xml", Value: "type"}},
+		},
+		"OmitEmptyAlways": {
+			tags:         []Tag{{Key: "json", Case: TagSnake, OmitEmpty: OmitEmptyAlways}},
+			rec:          &parse.Record{Identifier: "CUST-ID"},
+			fieldVarName: "CustID",
+			expected:     []tagData{{Key: "json", Value: "cust_id,omitempty"}},
+		},
+		"OmitEmptyRedefines_RedefiningField": {
+			tags:         []Tag{{Key: "json", Case: TagSnake, OmitEmpty: OmitEmptyRedefines}},
+			rec:          &parse.Record{Identifier: "CUST-CODE", Redefines: "CUST-ID"},
+			fieldVarName: "CustCode",
+			expected:     []tagData{{Key: "json", Value: "cust_code,omitempty"}},
+		},
+		"OmitEmptyRedefines_OtherField": {
+			tags:         []Tag{{Key: "json", Case: TagSnake, OmitEmpty: OmitEmptyRedefines}},
+			rec:          &parse.Record{Identifier: "CUST-ID"},
+			fieldVarName: "CustID",
+			expected:     []tagData{{Key: "json", Value: "cust_id"}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, buildTags(tt.tags, tt.rec, tt.fieldVarName))
+		})
+	}
+}
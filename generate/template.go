@@ -0,0 +1,47 @@
+package generate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+// templateFuncs are the helper functions available to every template, including custom templates.
+var templateFuncs = template.FuncMap{
+	"lower":      strings.ToLower,
+	"upper":      strings.ToUpper,
+	"goName":     toGoName,
+	"lowerCamel": lowerFirstWord,
+	"snake":      toSnakeIdentifier,
+	"quote":      strconv.Quote,
+	"join":       strings.Join,
+	"replace":    strings.ReplaceAll,
+	"trimPrefix": strings.TrimPrefix,
+	"trimSuffix": strings.TrimSuffix,
+	"hasPrefix":  strings.HasPrefix,
+	"hasSuffix":  strings.HasSuffix,
+	"contains":   strings.Contains,
+	"add":        func(a, b int) int { return a + b },
+	"sub":        func(a, b int) int { return a - b },
+}
+
+// ToTemplate generates output from a COBOL copybook AST with a custom text/template, which is executed
+// with the same TemplateParams as the built-in Go structs template. The output is returned as it is
+// executed, so that templates can generate artefacts other than Go code.
+func ToTemplate(ast []*parse.Record, copybookName, packageName string, typeOverrides map[parse.PicType]string, text string, opts ...Option) ([]byte, error) {
+	if len(ast) == 0 {
+		return nil, fmt.Errorf("ast is empty")
+	}
+
+	goGen := newGoStructsGenerator(typeOverrides, opts...)
+
+	data, err := goGen.buildTemplateParams(ast, copybookName, packageName)
+	if err != nil {
+		return nil, err
+	}
+
+	return executeTemplate(text, data)
+}
@@ -0,0 +1,79 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+func Test_ToTemplate(t *testing.T) {
+	ast := []*parse.Record{
+		{
+			Level:      1,
+			Identifier: "CUST-REC",
+			Children: []*parse.Record{
+				{
+					Level:      5,
+					Identifier: "CUST-ID",
+					Pic:        parse.Picture{PicString: "9(04)", PicType: parse.Unsigned, PicCount: 4, IntegerDigits: 4},
+				},
+				{
+					Level:      5,
+					Identifier: "CUST-NAME",
+					Pic:        parse.Picture{PicString: "X(10)", PicType: parse.Alpha, PicCount: 10},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		text        string
+		expected    []byte
+		assertError assert.ErrorAssertionFunc
+	}{
+		"Valid_CSVTemplate_ReturnsOutputAsExecuted": {
+			text: `struct,field,type,start,end
+{{- range .Structs }}{{ $struct := .StructVarName }}{{ range .Fields }}
+{{ $struct }},{{ .FieldVarName }},{{ .VarType }},{{ .PicGlobalStart }},{{ .PicGlobalEnd }}
+{{- end }}{{ end }}
+`,
+			expected: []byte(`struct,field,type,start,end
+Copybook,CustRec,CustRec,1,14
+CustRec,CustID,uint,1,4
+CustRec,CustName,string,5,14
+`),
+			assertError: assert.NoError,
+		},
+		"Valid_TemplateWithHelperFunctions_ReturnsOutputAsExecuted": {
+			text: `package {{ .Package }}
+{{ range (index .Structs 1).Fields }}
+{{ .FieldVarName }} {{ .VarType }} ` + "`json:\"{{ snake .Identifier }}\" db:{{ quote (lower .Identifier) }}`" + ` // {{ lowerCamel .FieldVarName }} {{ sub .PicGlobalEnd .PicGlobalStart | add 1 }} bytes
+{{- end }}
+`,
+			expected: []byte(`package main
+
+CustID uint ` + "`json:\"cust_id\" db:\"cust-id\"`" + ` // custID 4 bytes
+CustName string ` + "`json:\"cust_name\" db:\"cust-name\"`" + ` // custName 10 bytes
+`),
+			assertError: assert.NoError,
+		},
+		"Invalid_TemplateSyntax_ReturnsError": {
+			text:        `{{ range .Structs }}`,
+			assertError: assert.Error,
+		},
+		"Invalid_UnknownField_ReturnsError": {
+			text:        `{{ .Unknown }}`,
+			assertError: assert.Error,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ToTemplate(ast, "Copybook", "main", map[parse.PicType]string{}, tt.text)
+			tt.assertError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
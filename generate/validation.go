@@ -0,0 +1,439 @@
+package generate
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+// integerLimits are the smallest and largest values of the Go integer types, which int and uint share
+// with int64 and uint64 on 64-bit platforms.
+var integerLimits = map[string][2]*big.Int{
+	"int":    {big.NewInt(math.MinInt64), big.NewInt(math.MaxInt64)},
+	"int8":   {big.NewInt(math.MinInt8), big.NewInt(math.MaxInt8)},
+	"int16":  {big.NewInt(math.MinInt16), big.NewInt(math.MaxInt16)},
+	"int32":  {big.NewInt(math.MinInt32), big.NewInt(math.MaxInt32)},
+	"int64":  {big.NewInt(math.MinInt64), big.NewInt(math.MaxInt64)},
+	"uint":   {big.NewInt(0), new(big.Int).SetUint64(math.MaxUint64)},
+	"uint8":  {big.NewInt(0), big.NewInt(math.MaxUint8)},
+	"uint16": {big.NewInt(0), big.NewInt(math.MaxUint16)},
+	"uint32": {big.NewInt(0), big.NewInt(math.MaxUint32)},
+	"uint64": {big.NewInt(0), new(big.Int).SetUint64(math.MaxUint64)},
+}
+
+// validationMethods are the names of the methods that validate a struct, which its fields cannot have.
+var validationMethods = []string{"Validate", "validate"}
+
+// validationData represents the Validate method of a struct.
+type validationData struct {
+	Receiver string
+	Fields   []fieldValidationData
+}
+
+// fieldValidationData represents the checks of a field, or the validation of the struct of a group field.
+type fieldValidationData struct {
+	FieldVarName string
+	Group        bool
+	Occurs       bool
+	// Start and End are the one-based positions of the field, or of its first element for a table, and
+	// ElementSize is the size of an element of a table.
+	Start       int
+	End         int
+	ElementSize int
+	Checks      []checkData
+}
+
+// checkData represents a constraint of a field, which is violated when Violated is true of the value
+// given by Value. Message describes the violation, with a verb for the value.
+type checkData struct {
+	Value    string
+	Violated string
+	Message  string
+}
+
+// buildValidation builds the Validate method of a struct, which checks the length of its strings, the
+// digits and sign of its integers, the values of the condition names of its fields, and the structs of
+// its groups. Fields whose constraints cannot be checked, such as decimals with condition names, are
+// reported as warnings.
+func (g *goGenerator) buildValidation(structVarName string, records []*parse.Record, fields []FieldData) *validationData {
+	validation := &validationData{Receiver: receiverName(structVarName)}
+
+	// Fields that share their storage through REDEFINES only hold one meaningful value at a time.
+	redefined := make(map[string]bool, len(records))
+	for _, rec := range records {
+		if rec.Redefines != "" {
+			redefined[identifierKey(rec.Redefines)] = true
+		}
+	}
+
+	for i, rec := range records {
+		field := fields[i]
+		size := field.PicGlobalEnd - field.PicGlobalStart + 1
+		elementSize := size / max(1, rec.OccursCount)
+		fieldValidation := fieldValidationData{
+			FieldVarName: field.FieldVarName,
+			Group:        len(rec.Children) > 0,
+			Occurs:       rec.OccursCount > 1,
+			Start:        field.PicGlobalStart,
+			End:          field.PicGlobalStart + elementSize - 1,
+			ElementSize:  elementSize,
+		}
+		if fieldValidation.Group {
+			if len(rec.Conditions) > 0 {
+				g.warn(fmt.Sprintf("condition names of %s are not validated, as it is a group", rec.Identifier))
+			}
+			// The struct of a group has no methods to validate it if they clash with its fields.
+			if _, ok := g.validationClash(rec.Children); !ok {
+				validation.Fields = append(validation.Fields, fieldValidation)
+			}
+			continue
+		}
+
+		value := validation.Receiver + "." + field.FieldVarName
+		if fieldValidation.Occurs {
+			value += "[index]"
+		}
+		// The Go type of a table is the type of its elements.
+		goType := field.VarType[strings.Index(field.VarType, "]")+1:]
+		sharesStorage := rec.Redefines != "" || redefined[identifierKey(rec.Identifier)]
+		fieldValidation.Checks = g.buildChecks(rec, goType, value, sharesStorage)
+		if len(fieldValidation.Checks) > 0 {
+			validation.Fields = append(validation.Fields, fieldValidation)
+		}
+	}
+
+	return validation
+}
+
+// validationClash returns the name of a field of a struct that is also the name of a method that
+// validates the struct, and whether there is one.
+func (g *goGenerator) validationClash(records []*parse.Record) (string, bool) {
+	for _, rec := range records {
+		if name := g.fieldName(rec); slices.Contains(validationMethods, name) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// buildChecks builds the checks of an elementary record whose value is given by a Go expression.
+func (g *goGenerator) buildChecks(rec *parse.Record, goType, value string, sharesStorage bool) []checkData {
+	var checks []checkData
+	var conditionValue, conditionVerb string
+	_, integer := integerLimits[goType]
+	switch {
+	case goType == "string":
+		// Trailing spaces are padding, so like COBOL comparisons, strings are checked without them.
+		conditionValue, conditionVerb = `strings.TrimRight(`+value+`, " ")`, "%q"
+		// National and DBCS characters take two bytes each.
+		characters := rec.Pic.PicCount
+		if rec.Pic.PicType == parse.National || rec.Pic.PicType == parse.DBCS {
+			characters /= parse.DoubleByteCharacterWidth
+		}
+		checks = append(checks, checkData{
+			Value:    conditionValue,
+			Violated: fmt.Sprint("utf8.RuneCountInString(value) > ", characters),
+			Message:  "%q has more than " + countOf(characters, "character"),
+		})
+	case integer:
+		checks = append(checks, integerChecks(rec, goType, value)...)
+		conditionValue, conditionVerb = value, "%d"
+	case rec.Pic.PicType.IsNumeric() && !rec.Pic.Edited:
+		// The digits and sign of other Go types, such as the decimal types, cannot be told from their value.
+		g.warn(fmt.Sprintf("digits and sign of %s are not validated, as its Go type %s is neither an integer nor a string",
+			rec.Identifier, goType))
+	}
+
+	if len(rec.Conditions) == 0 {
+		return checks
+	}
+	var reason string
+	switch {
+	case sharesStorage:
+		reason = "it shares its storage through REDEFINES"
+	case conditionValue == "":
+		reason = fmt.Sprint("its Go type ", goType, " is neither an integer nor a string")
+	}
+	if reason != "" {
+		g.warn(fmt.Sprintf("condition names of %s are not validated, as %s", rec.Identifier, reason))
+		return checks
+	}
+
+	violated, err := conditionsViolated(rec, goType)
+	if err != nil {
+		g.warn(fmt.Sprintf("condition names of %s are not validated, as %v", rec.Identifier, err))
+		return checks
+	}
+	names := make([]string, 0, len(rec.Conditions))
+	for _, condition := range rec.Conditions {
+		names = append(names, condition.Identifier)
+	}
+	return append(checks, checkData{
+		Value:    conditionValue,
+		Violated: violated,
+		Message:  conditionVerb + " is not a value of " + strings.Join(names, ", "),
+	})
+}
+
+// integerChecks builds the checks of the sign and the number of digits of an integer, leaving out the
+// limits that its Go type cannot exceed.
+func integerChecks(rec *parse.Record, goType, value string) []checkData {
+	limits := integerLimits[goType]
+	var checks []checkData
+	if !rec.Pic.Signed && limits[0].Sign() < 0 {
+		checks = append(checks, checkData{
+			Value:    value,
+			Violated: "value < 0",
+			Message:  "%d is negative but the field is unsigned",
+		})
+	}
+
+	// The digits of native binary integers are not limited by their PIC clause, and integers that hold
+	// a decimal hold it in minor units.
+	digits := rec.Pic.IntegerDigits + rec.Pic.FractionDigits
+	if rec.Usage == parse.NativeBinary || !rec.Pic.PicType.IsNumeric() || digits == 0 {
+		return checks
+	}
+	largest := new(big.Int).Sub(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil), big.NewInt(1))
+	var violated []string
+	if largest.Cmp(limits[1]) < 0 {
+		violated = append(violated, "value > "+largest.String())
+	}
+	if rec.Pic.Signed && new(big.Int).Neg(largest).Cmp(limits[0]) > 0 {
+		violated = append(violated, "value < -"+largest.String())
+	}
+	if len(violated) > 0 {
+		checks = append(checks, checkData{
+			Value:    value,
+			Violated: strings.Join(violated, " || "),
+			Message:  "%d has more than " + countOf(digits, "digit"),
+		})
+	}
+
+	return checks
+}
+
+// conditionsViolated returns a Go expression that is true when a value is none of the values of the
+// condition names of a record.
+func conditionsViolated(rec *parse.Record, goType string) (string, error) {
+	var mismatches []string
+	for _, condition := range rec.Conditions {
+		bounds, err := conditionBounds(rec, goType, condition)
+		if err != nil {
+			return "", err
+		}
+		for _, b := range bounds {
+			mismatch := b.mismatch()
+			if mismatch == "false" {
+				// The value always matches the range, so it cannot violate the condition names.
+				return "", fmt.Errorf("%s covers every value of its Go type %s", condition.Identifier, goType)
+			}
+			mismatches = append(mismatches, mismatch)
+		}
+	}
+	if len(mismatches) == 0 {
+		return "", fmt.Errorf("its Go type %s cannot hold any of their values", goType)
+	}
+
+	return strings.Join(mismatches, " && "), nil
+}
+
+// conditionMatched returns a Go expression that is true when a value is one of the values of a condition
+// name of a record.
+func conditionMatched(rec *parse.Record, goType string, condition parse.Condition) (string, error) {
+	bounds, err := conditionBounds(rec, goType, condition)
+	if err != nil {
+		return "", err
+	}
+	if len(bounds) == 0 {
+		return "", fmt.Errorf("its Go type %s cannot hold any of the values of %s", goType, condition.Identifier)
+	}
+
+	matches := make([]string, 0, len(bounds))
+	for _, b := range bounds {
+		matches = append(matches, b.match())
+	}
+	return strings.Join(matches, " || "), nil
+}
+
+// valueBounds are the bounds of a range of values of a condition name as Go literals. A bound is empty
+// when the Go type of the field cannot hold a value beyond it, and single ranges only have a low bound.
+type valueBounds struct {
+	low, high string
+	single    bool
+}
+
+// mismatch returns a Go expression that is true when a value is outside of the bounds, which is false
+// when the Go type cannot hold such a value.
+func (b valueBounds) mismatch() string {
+	if b.single {
+		return "value != " + b.low
+	}
+	var mismatches []string
+	if b.low != "" {
+		mismatches = append(mismatches, "value < "+b.low)
+	}
+	if b.high != "" {
+		mismatches = append(mismatches, "value > "+b.high)
+	}
+	if len(mismatches) == 0 {
+		return "false"
+	}
+	return "(" + strings.Join(mismatches, " || ") + ")"
+}
+
+// match returns a Go expression that is true when a value is within the bounds, which is true when the
+// Go type cannot hold a value outside of them.
+func (b valueBounds) match() string {
+	if b.single {
+		return "value == " + b.low
+	}
+	var matches []string
+	if b.low != "" {
+		matches = append(matches, "value >= "+b.low)
+	}
+	if b.high != "" {
+		matches = append(matches, "value <= "+b.high)
+	}
+	if len(matches) == 0 {
+		return "true"
+	}
+	return "(" + strings.Join(matches, " && ") + ")"
+}
+
+// conditionBounds returns the bounds of the ranges of values of a condition name, leaving out the ranges
+// that the Go type of the record cannot hold a value of.
+func conditionBounds(rec *parse.Record, goType string, condition parse.Condition) ([]valueBounds, error) {
+	var bounds []valueBounds
+	for _, valueRange := range condition.Values {
+		through := valueRange.From
+		if valueRange.Through != nil {
+			through = *valueRange.Through
+		}
+
+		var b valueBounds
+		var ok bool
+		var err error
+		if goType == "string" {
+			b, err = stringBounds(rec, valueRange.From, through)
+			ok = true
+		} else {
+			b, ok, err = integerBounds(rec, goType, valueRange.From, through)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			bounds = append(bounds, b)
+		}
+	}
+	return bounds, nil
+}
+
+// stringBounds returns the bounds of a range of string values.
+func stringBounds(rec *parse.Record, from, through parse.Literal) (valueBounds, error) {
+	fromString, err := stringLiteral(rec, from)
+	if err != nil {
+		return valueBounds{}, err
+	}
+	if from == through {
+		return valueBounds{low: fromString, single: true}, nil
+	}
+
+	throughString, err := stringLiteral(rec, through)
+	if err != nil {
+		return valueBounds{}, err
+	}
+	return valueBounds{low: fromString, high: throughString}, nil
+}
+
+// integerBounds returns the bounds of a range of integer values, limited to the values that its Go type
+// can hold. It returns false when the Go type cannot hold a value within the range.
+func integerBounds(rec *parse.Record, goType string, from, through parse.Literal) (valueBounds, bool, error) {
+	low, err := integerLiteral(rec, from)
+	if err != nil {
+		return valueBounds{}, false, err
+	}
+	high, err := integerLiteral(rec, through)
+	if err != nil {
+		return valueBounds{}, false, err
+	}
+
+	limits := integerLimits[goType]
+	if high.Cmp(limits[0]) < 0 || low.Cmp(limits[1]) > 0 || low.Cmp(high) > 0 {
+		return valueBounds{}, false, nil
+	}
+	if low.Cmp(high) == 0 {
+		return valueBounds{low: low.String(), single: true}, true, nil
+	}
+	var b valueBounds
+	if low.Cmp(limits[0]) > 0 {
+		b.low = low.String()
+	}
+	if high.Cmp(limits[1]) < 0 {
+		b.high = high.String()
+	}
+	return b, true, nil
+}
+
+// stringLiteral returns a literal of a condition name as a Go string literal without trailing spaces.
+func stringLiteral(rec *parse.Record, literal parse.Literal) (string, error) {
+	switch {
+	case literal.Kind == parse.AlphanumericLiteral:
+		return strconv.Quote(strings.TrimRight(literal.Text, " ")), nil
+	case literal.Kind == parse.FigurativeConstant && literal.Text == "SPACE":
+		return `""`, nil
+	case literal.Kind == parse.FigurativeConstant && literal.Text == "ZERO":
+		return strconv.Quote(strings.Repeat("0", rec.Pic.PicCount)), nil
+	}
+	return "", fmt.Errorf("%s is not an alphanumeric value", literalText(literal))
+}
+
+// integerLiteral returns a literal of a condition name as an integer, in minor units for a decimal.
+func integerLiteral(rec *parse.Record, literal parse.Literal) (*big.Int, error) {
+	switch {
+	case literal.Kind == parse.FigurativeConstant && literal.Text == "ZERO":
+		return big.NewInt(0), nil
+	case literal.Kind != parse.NumericLiteral:
+		return nil, fmt.Errorf("%s is not a numeric value", literalText(literal))
+	}
+	return minorUnits(literal.Text, rec.Pic.FractionDigits)
+}
+
+// minorUnits returns a number as an integer in minor units of a scale, e.g. 150 for 1.5 with a scale of 2.
+func minorUnits(number string, scale int) (*big.Int, error) {
+	integerPart, fractionPart, _ := strings.Cut(number, ".")
+	if len(fractionPart) > scale {
+		if strings.Trim(fractionPart[scale:], "0") != "" {
+			return nil, fmt.Errorf("%s has more than %d decimal places", number, scale)
+		}
+		fractionPart = fractionPart[:scale]
+	}
+	digits := integerPart + fractionPart + strings.Repeat("0", scale-len(fractionPart))
+	value, ok := new(big.Int).SetString(strings.TrimLeft(digits, "+"), 10)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a number", number)
+	}
+	return value, nil
+}
+
+// countOf returns a count of a noun, e.g. 1 digit or 2 digits.
+func countOf(count int, noun string) string {
+	if count == 1 {
+		return "1 " + noun
+	}
+	return strconv.Itoa(count) + " " + noun + "s"
+}
+
+// literalText returns a literal as it is written in a copybook.
+func literalText(literal parse.Literal) string {
+	if literal.Kind == parse.AlphanumericLiteral {
+		return strconv.Quote(literal.Text)
+	}
+	return literal.Text
+}
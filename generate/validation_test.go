@@ -0,0 +1,215 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+func Test_buildChecks(t *testing.T) {
+	alphanumeric := func(value string) parse.Literal {
+		return parse.Literal{Kind: parse.AlphanumericLiteral, Text: value}
+	}
+	numeric := func(value string) parse.Literal {
+		return parse.Literal{Kind: parse.NumericLiteral, Text: value}
+	}
+
+	tests := map[string]struct {
+		rec              *parse.Record
+		goType           string
+		sharesStorage    bool
+		expected         []checkData
+		expectedWarnings []string
+	}{
+		"String": {
+			rec:    &parse.Record{Identifier: "NAME", Pic: parse.Picture{PicType: parse.Alpha, PicCount: 10}},
+			goType: "string",
+			expected: []checkData{
+				{Value: `strings.TrimRight(r.Name, " ")`, Violated: "utf8.RuneCountInString(value) > 10", Message: "%q has more than 10 characters"},
+			},
+		},
+		"StringWithConditions": {
+			rec: &parse.Record{
+				Identifier: "STATUS", Pic: parse.Picture{PicType: parse.Alpha, PicCount: 2},
+				Conditions: []parse.Condition{
+					{Identifier: "OPEN", Values: []parse.ValueRange{{From: alphanumeric("OP ")}}},
+					{Identifier: "CLOSED", Values: []parse.ValueRange{
+						{From: parse.Literal{Kind: parse.FigurativeConstant, Text: "SPACE"}},
+						{From: alphanumeric("X0"), Through: &parse.Literal{Kind: parse.AlphanumericLiteral, Text: "X9"}},
+					}},
+				},
+			},
+			goType: "string",
+			expected: []checkData{
+				{Value: `strings.TrimRight(r.Status, " ")`, Violated: "utf8.RuneCountInString(value) > 2", Message: "%q has more than 2 characters"},
+				{
+					Value:    `strings.TrimRight(r.Status, " ")`,
+					Violated: `value != "OP" && value != "" && (value < "X0" || value > "X9")`,
+					Message:  "%q is not a value of OPEN, CLOSED",
+				},
+			},
+		},
+		"NationalString": {
+			rec:    &parse.Record{Identifier: "NAME", Pic: parse.Picture{PicType: parse.National, PicCount: 8}},
+			goType: "string",
+			expected: []checkData{
+				{Value: `strings.TrimRight(r.Name, " ")`, Violated: "utf8.RuneCountInString(value) > 4", Message: "%q has more than 4 characters"},
+			},
+		},
+		"DBCSString": {
+			rec:    &parse.Record{Identifier: "NAME", Pic: parse.Picture{PicType: parse.DBCS, PicCount: 2}},
+			goType: "string",
+			expected: []checkData{
+				{Value: `strings.TrimRight(r.Name, " ")`, Violated: "utf8.RuneCountInString(value) > 1", Message: "%q has more than 1 character"},
+			},
+		},
+		"UnsignedInteger": {
+			rec:    &parse.Record{Identifier: "COUNT", Pic: parse.Picture{PicType: parse.Unsigned, IntegerDigits: 3}},
+			goType: "uint",
+			expected: []checkData{
+				{Value: "r.Count", Violated: "value > 999", Message: "%d has more than 3 digits"},
+			},
+		},
+		"UnsignedPicInSignedType": {
+			rec:    &parse.Record{Identifier: "COUNT", Pic: parse.Picture{PicType: parse.Unsigned, IntegerDigits: 1}},
+			goType: "int",
+			expected: []checkData{
+				{Value: "r.Count", Violated: "value < 0", Message: "%d is negative but the field is unsigned"},
+				{Value: "r.Count", Violated: "value > 9", Message: "%d has more than 1 digit"},
+			},
+		},
+		"SignedDecimalInMinorUnitsWithConditions": {
+			rec: &parse.Record{
+				Identifier: "AMOUNT", Pic: parse.Picture{PicType: parse.Decimal, IntegerDigits: 5, FractionDigits: 2, Signed: true},
+				Conditions: []parse.Condition{
+					{Identifier: "SMALL", Values: []parse.ValueRange{{From: numeric("-1.5"), Through: &parse.Literal{Kind: parse.NumericLiteral, Text: "10"}}}},
+					{Identifier: "NONE", Values: []parse.ValueRange{{From: parse.Literal{Kind: parse.FigurativeConstant, Text: "ZERO"}}}},
+				},
+			},
+			goType: "int64",
+			expected: []checkData{
+				{Value: "r.Amount", Violated: "value > 9999999 || value < -9999999", Message: "%d has more than 7 digits"},
+				{Value: "r.Amount", Violated: "(value < -150 || value > 1000) && value != 0", Message: "%d is not a value of SMALL, NONE"},
+			},
+		},
+		"DigitsBeyondGoType_NotChecked": {
+			rec: &parse.Record{
+				Identifier: "CODE", Pic: parse.Picture{PicType: parse.Unsigned, IntegerDigits: 3},
+				Conditions: []parse.Condition{
+					{Identifier: "LOW", Values: []parse.ValueRange{{From: numeric("-5"), Through: &parse.Literal{Kind: parse.NumericLiteral, Text: "9"}}}},
+					{Identifier: "HIGH", Values: []parse.ValueRange{{From: numeric("300")}}},
+				},
+			},
+			goType: "uint8",
+			expected: []checkData{
+				{Value: "r.Code", Violated: "(value > 9)", Message: "%d is not a value of LOW, HIGH"},
+			},
+		},
+		"NativeBinary_DigitsNotChecked": {
+			rec:    &parse.Record{Identifier: "COUNT", Pic: parse.Picture{PicType: parse.Unsigned, IntegerDigits: 3}, Usage: parse.NativeBinary},
+			goType: "uint",
+		},
+		"DecimalType_Warned": {
+			rec:              &parse.Record{Identifier: "RATE", Pic: parse.Picture{PicType: parse.Decimal, IntegerDigits: 1, FractionDigits: 2}},
+			goType:           "decimal.Decimal",
+			expectedWarnings: []string{"digits and sign of RATE are not validated, as its Go type decimal.Decimal is neither an integer nor a string"},
+		},
+		"ConditionsOfDecimalType_Warned": {
+			rec: &parse.Record{
+				Identifier: "RATE", Pic: parse.Picture{PicType: parse.Decimal, IntegerDigits: 1, FractionDigits: 2},
+				Conditions: []parse.Condition{{Identifier: "NONE", Values: []parse.ValueRange{{From: numeric("0")}}}},
+			},
+			goType: "decimal.Decimal",
+			expectedWarnings: []string{
+				"digits and sign of RATE are not validated, as its Go type decimal.Decimal is neither an integer nor a string",
+				"condition names of RATE are not validated, as its Go type decimal.Decimal is neither an integer nor a string",
+			},
+		},
+		"ConditionsOfRedefinedField_Warned": {
+			rec: &parse.Record{
+				Identifier: "FLAG", Pic: parse.Picture{PicType: parse.Alpha, PicCount: 1},
+				Conditions: []parse.Condition{{Identifier: "ON", Values: []parse.ValueRange{{From: alphanumeric("Y")}}}},
+			},
+			goType:        "string",
+			sharesStorage: true,
+			expected: []checkData{
+				{Value: `strings.TrimRight(r.Flag, " ")`, Violated: "utf8.RuneCountInString(value) > 1", Message: "%q has more than 1 character"},
+			},
+			expectedWarnings: []string{"condition names of FLAG are not validated, as it shares its storage through REDEFINES"},
+		},
+		"NumericConditionOfString_Warned": {
+			rec: &parse.Record{
+				Identifier: "FLAG", Pic: parse.Picture{PicType: parse.Alpha, PicCount: 1},
+				Conditions: []parse.Condition{{Identifier: "ON", Values: []parse.ValueRange{{From: numeric("1")}}}},
+			},
+			goType: "string",
+			expected: []checkData{
+				{Value: `strings.TrimRight(r.Flag, " ")`, Violated: "utf8.RuneCountInString(value) > 1", Message: "%q has more than 1 character"},
+			},
+			expectedWarnings: []string{"condition names of FLAG are not validated, as 1 is not an alphanumeric value"},
+		},
+		"ConditionCoveringGoType_Warned": {
+			rec: &parse.Record{
+				Identifier: "CODE", Pic: parse.Picture{PicType: parse.Unsigned, IntegerDigits: 3, ScaleFactor: 0},
+				Usage:      parse.NativeBinary,
+				Conditions: []parse.Condition{{Identifier: "ANY", Values: []parse.ValueRange{{From: numeric("0"), Through: &parse.Literal{Kind: parse.NumericLiteral, Text: "999"}}}}},
+			},
+			goType:           "uint8",
+			expectedWarnings: []string{"condition names of CODE are not validated, as ANY covers every value of its Go type uint8"},
+		},
+		"ConditionWithMoreDecimalPlaces_Warned": {
+			rec: &parse.Record{
+				Identifier: "COUNT", Pic: parse.Picture{PicType: parse.Unsigned, IntegerDigits: 1},
+				Conditions: []parse.Condition{{Identifier: "HALF", Values: []parse.ValueRange{{From: numeric(".5")}}}},
+			},
+			goType: "uint",
+			expected: []checkData{
+				{Value: "r.Count", Violated: "value > 9", Message: "%d has more than 1 digit"},
+			},
+			expectedWarnings: []string{"condition names of COUNT are not validated, as .5 has more than 0 decimal places"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var warnings []string
+			g := newGoGenerator(defaultTypeMapping(), WithWarningHandler(func(warning string) { warnings = append(warnings, warning) }))
+			value := "r." + g.naming.fieldName(tt.rec.Identifier)
+
+			assert.Equal(t, tt.expected, g.buildChecks(tt.rec, tt.goType, value, tt.sharesStorage))
+			assert.Equal(t, tt.expectedWarnings, warnings)
+		})
+	}
+}
+
+func Test_buildValidation_FieldNamedValidate(t *testing.T) {
+	ast := []*parse.Record{{
+		Level: 1, Identifier: "RECORD",
+		Children: []*parse.Record{
+			{Level: 5, Identifier: "NAME", Pic: parse.Picture{PicString: "X(02)", PicType: parse.Alpha, PicCount: 2}},
+			{
+				Level: 5, Identifier: "DETAIL",
+				Children: []*parse.Record{
+					{Level: 10, Identifier: "VALIDATE", Pic: parse.Picture{PicString: "X(01)", PicType: parse.Alpha, PicCount: 1}},
+				},
+			},
+		},
+	}}
+	var warnings []string
+	g := newGoGenerator(defaultTypeMapping(), WithValidation(true),
+		WithWarningHandler(func(warning string) { warnings = append(warnings, warning) }))
+
+	structs, err := g.buildCopybookStructData("Copybook", ast)
+
+	require.NoError(t, err)
+	require.Len(t, structs, 3)
+	// The struct of the group is not validated by the struct it is in, as it has no validate method.
+	assert.Equal(t, []fieldValidationData{{FieldVarName: "Name", Start: 1, End: 2, ElementSize: 2, Checks: []checkData{
+		{Value: `strings.TrimRight(r.Name, " ")`, Violated: "utf8.RuneCountInString(value) > 2", Message: "%q has more than 2 characters"},
+	}}}, structs[1].Validation.Fields)
+	assert.Nil(t, structs[2].Validation)
+	assert.Equal(t, []string{"Validate method of Detail is not generated, as Validate is the name of one of its fields"}, warnings)
+}
@@ -0,0 +1,239 @@
+package generate
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+// variantsData represents the type that holds the bytes of a field and of the fields that redefine it,
+// which are the variants of the bytes. It replaces the fields in their struct.
+type variantsData struct {
+	TypeName     string
+	FieldVarName string
+	// PicTag is the value of the pic struct tag of the field holding the bytes, and PicGlobalEnd is its
+	// last position, as its size is the size of its largest variant.
+	PicTag       string
+	PicGlobalEnd int
+	Size         int
+	Views        []viewData
+	// Selector is the method that decodes the variant selected by a discriminator, or nil if the
+	// variants are not annotated with the condition names that select them.
+	Selector *selectorData
+}
+
+// viewData represents the methods that decode and encode a variant from and to the bytes it shares with
+// the other variants.
+type viewData struct {
+	Name    string
+	VarType string
+	PicTag  string
+	Size    int
+}
+
+// selectorData represents a method that decodes the variant selected by the condition names of a
+// discriminator field.
+type selectorData struct {
+	Name          string
+	Receiver      string
+	Discriminator string
+	Value         string
+	Verb          string
+	Cases         []selectorCaseData
+}
+
+// selectorCaseData represents the condition that selects a variant.
+type selectorCaseData struct {
+	Matched string
+	View    string
+}
+
+// whenAnnotation matches the annotation of the condition name that selects a variant in its inline
+// comment, e.g. *> @when(DETAIL-IS-TEXT).
+var whenAnnotation = regexp.MustCompile(`(?i)@when\(\s*([^)\s]*)\s*\)`)
+
+// codecTypeName returns the name of the interface that decodes and encodes the variants of a copybook.
+func codecTypeName(copybookTypeName string) string {
+	return copybookTypeName + "Codec"
+}
+
+// redefinitionGroups returns the indexes of the records that share their bytes through REDEFINES, in
+// groups that start with the record that is redefined. A record may redefine a record that itself
+// redefines another, which are in the same group.
+func redefinitionGroups(records []*parse.Record) [][]int {
+	var groups [][]int
+	rootOf := make(map[string]int)
+	groupOf := make(map[int]int)
+	for i, rec := range records {
+		root := i
+		if rec.Redefines != "" {
+			var ok bool
+			if root, ok = rootOf[identifierKey(rec.Redefines)]; !ok {
+				continue
+			}
+			group, ok := groupOf[root]
+			if !ok {
+				group = len(groups)
+				groupOf[root] = group
+				groups = append(groups, []int{root})
+			}
+			groups[group] = append(groups[group], i)
+		}
+		rootOf[identifierKey(rec.Identifier)] = root
+	}
+
+	return groups
+}
+
+// buildVariants builds the variants of the fields of a struct that share their bytes through REDEFINES.
+// The fields start with the first field of the struct, so their local positions are relative to it.
+func (g *goGenerator) buildVariants(structVarName string, records []*parse.Record, fields []FieldData) []variantsData {
+	var allVariants []variantsData
+	for _, group := range redefinitionGroups(records) {
+		base := fields[group[0]]
+		variants := variantsData{
+			TypeName:     g.variantsTypeNames[records[group[0]]],
+			FieldVarName: base.FieldVarName,
+		}
+		for _, i := range group {
+			variants.Size = max(variants.Size, fields[i].PicSize)
+			// The view of a variant decodes the variant from the start of the bytes.
+			variants.Views = append(variants.Views, viewData{
+				Name:    upperFirst(fields[i].FieldVarName),
+				VarType: fields[i].VarType,
+				PicTag:  getPicTag(records[i], fields[i].PicSize, 1, nil),
+				Size:    fields[i].PicSize,
+			})
+		}
+		localStart := base.PicGlobalStart - fields[0].PicGlobalStart + 1
+		variants.PicTag = fmt.Sprintf("%d,%d,clause=X(%02d)", localStart, localStart+variants.Size-1, variants.Size)
+		variants.PicGlobalEnd = base.PicGlobalStart + variants.Size - 1
+		variants.Selector = g.buildSelector(structVarName, records, fields, group)
+
+		allVariants = append(allVariants, variants)
+	}
+
+	return allVariants
+}
+
+// buildSelector builds the method that decodes the variant selected by the condition names that the
+// variants of a group are annotated with, which must all belong to the same elementary field of the
+// struct. A selector that cannot be built is reported as a warning.
+func (g *goGenerator) buildSelector(structVarName string, records []*parse.Record, fields []FieldData, group []int) *selectorData {
+	base := records[group[0]]
+	var discriminator *parse.Record
+	var discriminatorField FieldData
+	var conditions []parse.Condition
+	var views []string
+	var reason string
+	for _, i := range group {
+		match := whenAnnotation.FindStringSubmatch(records[i].Comment)
+		if match == nil {
+			continue
+		}
+
+		owner, condition, ok := findCondition(records, match[1])
+		switch {
+		case !ok:
+			reason = fmt.Sprint("condition name ", match[1], " of ", records[i].Identifier, " is not found in ", structVarName)
+		case discriminator != nil && owner != discriminator:
+			reason = "its condition names belong to different fields"
+		}
+		if reason != "" {
+			break
+		}
+		discriminator = owner
+		discriminatorField = fields[slices.Index(records, owner)]
+		conditions = append(conditions, condition)
+		views = append(views, upperFirst(fields[i].FieldVarName))
+	}
+	if discriminator == nil && reason == "" {
+		return nil
+	}
+
+	_, integer := integerLimits[discriminatorField.VarType]
+	switch {
+	case reason != "":
+	case discriminator.Redefines != "" || slices.ContainsFunc(records, func(rec *parse.Record) bool {
+		return rec.Redefines != "" && identifierKey(rec.Redefines) == identifierKey(discriminator.Identifier)
+	}):
+		reason = fmt.Sprint(discriminator.Identifier, " shares its storage through REDEFINES")
+	case len(discriminator.Children) > 0 || discriminator.OccursCount > 1:
+		reason = fmt.Sprint(discriminator.Identifier, " is a group or a table")
+	case !integer && discriminatorField.VarType != "string":
+		reason = fmt.Sprint("the Go type ", discriminatorField.VarType, " of ", discriminator.Identifier,
+			" is neither an integer nor a string")
+	}
+	if reason != "" {
+		g.warn(fmt.Sprintf("active variant of %s is not selected, as %s", base.Identifier, reason))
+		return nil
+	}
+
+	name := "Active" + upperFirst(g.fieldName(base))
+	if slices.ContainsFunc(fields, func(f FieldData) bool { return f.FieldVarName == name }) {
+		g.warn(fmt.Sprintf("active variant of %s is not selected, as %s is used by a field of %s", base.Identifier,
+			name, structVarName))
+		return nil
+	}
+
+	receiver := receiverName(structVarName)
+	selector := &selectorData{
+		Name:          name,
+		Receiver:      receiver,
+		Discriminator: discriminatorField.FieldVarName,
+		Value:         receiver + "." + discriminatorField.FieldVarName,
+		Verb:          "%d",
+	}
+	if !integer {
+		// Trailing spaces are padding, so like COBOL comparisons, strings are compared without them.
+		selector.Value, selector.Verb = `strings.TrimRight(`+selector.Value+`, " ")`, "%q"
+	}
+	for i, condition := range conditions {
+		matched, err := conditionMatched(discriminator, discriminatorField.VarType, condition)
+		if err != nil {
+			g.warn(fmt.Sprintf("active variant of %s is not selected, as %v", base.Identifier, err))
+			return nil
+		}
+		selector.Cases = append(selector.Cases, selectorCaseData{Matched: matched, View: views[i]})
+	}
+
+	return selector
+}
+
+// findCondition returns the record that a condition name belongs to and the condition name.
+func findCondition(records []*parse.Record, identifier string) (*parse.Record, parse.Condition, bool) {
+	for _, rec := range records {
+		for _, condition := range rec.Conditions {
+			if identifierKey(condition.Identifier) == identifierKey(identifier) {
+				return rec, condition, true
+			}
+		}
+	}
+	return nil, parse.Condition{}, false
+}
+
+// withoutVariants returns the records of a struct and their fields without the records that share their
+// bytes through REDEFINES, which are replaced by the fields holding the bytes of their variants.
+func withoutVariants(records []*parse.Record, fields []FieldData) ([]*parse.Record, []FieldData) {
+	variant := make(map[int]bool)
+	for _, group := range redefinitionGroups(records) {
+		for _, i := range group {
+			variant[i] = true
+		}
+	}
+	if len(variant) == 0 {
+		return records, fields
+	}
+
+	var remainingRecords []*parse.Record
+	var remainingFields []FieldData
+	for i, rec := range records {
+		if !variant[i] {
+			remainingRecords = append(remainingRecords, rec)
+			remainingFields = append(remainingFields, fields[i])
+		}
+	}
+	return remainingRecords, remainingFields
+}
@@ -0,0 +1,157 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasv98/copybooktogo/parse"
+)
+
+func Test_redefinitionGroups(t *testing.T) {
+	tests := map[string]struct {
+		records  []*parse.Record
+		expected [][]int
+	}{
+		"NoRedefinitions": {
+			records: []*parse.Record{{Identifier: "A"}, {Identifier: "B"}},
+		},
+		"RedefinitionsOfTheSameField": {
+			records: []*parse.Record{
+				{Identifier: "A"}, {Identifier: "B"}, {Identifier: "C", Redefines: "B"},
+				{Identifier: "D", Redefines: "b"}, {Identifier: "E"},
+			},
+			expected: [][]int{{1, 2, 3}},
+		},
+		"RedefinitionOfARedefinition": {
+			records: []*parse.Record{
+				{Identifier: "A"}, {Identifier: "B", Redefines: "A"}, {Identifier: "C", Redefines: "B"},
+			},
+			expected: [][]int{{0, 1, 2}},
+		},
+		"SeparateGroups": {
+			records: []*parse.Record{
+				{Identifier: "A"}, {Identifier: "B", Redefines: "A"}, {Identifier: "C"}, {Identifier: "D", Redefines: "C"},
+			},
+			expected: [][]int{{0, 1}, {2, 3}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, redefinitionGroups(tt.records))
+		})
+	}
+}
+
+func Test_buildVariants(t *testing.T) {
+	alphanumeric := func(value string) parse.Literal {
+		return parse.Literal{Kind: parse.AlphanumericLiteral, Text: value}
+	}
+	numeric := func(value string) parse.Literal {
+		return parse.Literal{Kind: parse.NumericLiteral, Text: value}
+	}
+	kind := &parse.Record{
+		Identifier: "KIND", Pic: parse.Picture{PicString: "9(01)", PicType: parse.Unsigned, PicCount: 1, IntegerDigits: 1},
+		Conditions: []parse.Condition{
+			{Identifier: "IS-TEXT", Values: []parse.ValueRange{{From: numeric("1"), Through: &parse.Literal{Kind: parse.NumericLiteral, Text: "3"}}}},
+			{Identifier: "IS-NUMBER", Values: []parse.ValueRange{{From: numeric("4")}}},
+		},
+	}
+	detail := func(comment string) *parse.Record {
+		return &parse.Record{
+			Identifier: "DETAIL", Pic: parse.Picture{PicString: "X(04)", PicType: parse.Alpha, PicCount: 4}, Comment: comment,
+		}
+	}
+	number := func(comment string) *parse.Record {
+		return &parse.Record{
+			Identifier: "NUMBER", Redefines: "DETAIL", Comment: comment,
+			Pic: parse.Picture{PicString: "9(02)", PicType: parse.Unsigned, PicCount: 2, IntegerDigits: 2},
+		}
+	}
+	views := []viewData{
+		{Name: "Detail", VarType: "string", PicTag: "1,4,clause=X(04)", Size: 4},
+		{Name: "Number", VarType: "uint", PicTag: "1,2,intdigits=2,clause=9(02)", Size: 2},
+	}
+
+	tests := map[string]struct {
+		records          []*parse.Record
+		expected         []variantsData
+		expectedWarnings []string
+	}{
+		"WithoutSelector": {
+			records: []*parse.Record{kind, detail(""), number("")},
+			expected: []variantsData{{
+				TypeName: "DetailVariants", FieldVarName: "Detail", PicTag: "2,5,clause=X(04)", PicGlobalEnd: 5, Size: 4,
+				Views: views,
+			}},
+		},
+		"WithSelector": {
+			records: []*parse.Record{kind, detail("@when(is-text)"), number("@when(IS-NUMBER)")},
+			expected: []variantsData{{
+				TypeName: "DetailVariants", FieldVarName: "Detail", PicTag: "2,5,clause=X(04)", PicGlobalEnd: 5, Size: 4,
+				Views: views,
+				Selector: &selectorData{
+					Name: "ActiveDetail", Receiver: "r", Discriminator: "Kind", Value: "r.Kind", Verb: "%d",
+					Cases: []selectorCaseData{
+						{Matched: "(value >= 1 && value <= 3)", View: "Detail"},
+						{Matched: "value == 4", View: "Number"},
+					},
+				},
+			}},
+		},
+		"UnknownCondition_Warned": {
+			records: []*parse.Record{kind, detail("@when(IS-DATE)"), number("")},
+			expected: []variantsData{{
+				TypeName: "DetailVariants", FieldVarName: "Detail", PicTag: "2,5,clause=X(04)", PicGlobalEnd: 5, Size: 4,
+				Views: views,
+			}},
+			expectedWarnings: []string{"active variant of DETAIL is not selected, as condition name IS-DATE of DETAIL is not found in Record"},
+		},
+		"ConditionsOfDifferentFields_Warned": {
+			records: []*parse.Record{
+				kind,
+				{
+					Identifier: "FLAG", Pic: parse.Picture{PicString: "X(01)", PicType: parse.Alpha, PicCount: 1},
+					Conditions: []parse.Condition{{Identifier: "IS-FLAGGED", Values: []parse.ValueRange{{From: alphanumeric("Y")}}}},
+				},
+				detail("@when(IS-TEXT)"), number("@when(IS-FLAGGED)"),
+			},
+			expected: []variantsData{{
+				TypeName: "DetailVariants", FieldVarName: "Detail", PicTag: "3,6,clause=X(04)", PicGlobalEnd: 6, Size: 4,
+				Views: views,
+			}},
+			expectedWarnings: []string{"active variant of DETAIL is not selected, as its condition names belong to different fields"},
+		},
+		"ConditionOfVariant_Warned": {
+			records: []*parse.Record{
+				{
+					Identifier: "DETAIL", Pic: parse.Picture{PicString: "X(04)", PicType: parse.Alpha, PicCount: 4},
+					Conditions: []parse.Condition{{Identifier: "IS-BLANK", Values: []parse.ValueRange{{From: alphanumeric(" ")}}}},
+					Comment:    "@when(IS-BLANK)",
+				},
+				number(""),
+			},
+			expected: []variantsData{{
+				TypeName: "DetailVariants", FieldVarName: "Detail", PicTag: "1,4,clause=X(04)", PicGlobalEnd: 4, Size: 4,
+				Views: views,
+			}},
+			expectedWarnings: []string{"active variant of DETAIL is not selected, as DETAIL shares its storage through REDEFINES"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var warnings []string
+			g := newGoGenerator(defaultTypeMapping(), WithRedefinesVariants(true),
+				WithWarningHandler(func(warning string) { warnings = append(warnings, warning) }))
+			require.NoError(t, g.resolveNames("RECORD", tt.records))
+			fields, err := g.buildFieldsData(tt.records, "RECORD")
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.expected, g.buildVariants("Record", tt.records, fields))
+			assert.Equal(t, tt.expectedWarnings, warnings)
+		})
+	}
+}
@@ -158,44 +158,52 @@ var g = &grammar{
 				exprs: []any{
 					&labeledExpr{
 						pos:   position{line: 50, col: 11, offset: 1438},
+						label: "line",
+						expr: &ruleRefExpr{
+							pos:  position{line: 50, col: 16, offset: 1443},
+							name: "RecordLine",
+						},
+					},
+					&labeledExpr{
+						pos:   position{line: 50, col: 27, offset: 1454},
 						label: "level",
 						expr: &ruleRefExpr{
-							pos:  position{line: 50, col: 17, offset: 1444},
+							pos:  position{line: 50, col: 33, offset: 1460},
 							name: "Level",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 50, col: 23, offset: 1450},
+						pos:  position{line: 50, col: 39, offset: 1466},
 						name: "SpacesOrEOLs",
 					},
 					&labeledExpr{
-						pos:   position{line: 50, col: 36, offset: 1463},
+						pos:   position{line: 50, col: 52, offset: 1479},
 						label: "identifier",
 						expr: &ruleRefExpr{
-							pos:  position{line: 50, col: 47, offset: 1474},
+							pos:  position{line: 50, col: 63, offset: 1490},
 							name: "Identifier",
 						},
 					},
 					&labeledExpr{
-						pos:   position{line: 50, col: 58, offset: 1485},
+						pos:   position{line: 50, col: 74, offset: 1501},
 						label: "clauses",
 						expr: &zeroOrMoreExpr{
-							pos: position{line: 50, col: 66, offset: 1493},
+							pos: position{line: 50, col: 82, offset: 1509},
 							expr: &actionExpr{
-								pos: position{line: 50, col: 67, offset: 1494},
-								run: (*parser).callonRecord9,
+								pos: position{line: 50, col: 83, offset: 1510},
+								run: (*parser).callonRecord11,
 								expr: &seqExpr{
-									pos: position{line: 50, col: 67, offset: 1494},
+									pos: position{line: 50, col: 83, offset: 1510},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 50, col: 67, offset: 1494},
+											pos:  position{line: 50, col: 83, offset: 1510},
 											name: "SpacesOrEOLs",
 										},
 										&labeledExpr{
-											pos:   position{line: 50, col: 80, offset: 1507},
+											pos:   position{line: 50, col: 96, offset: 1523},
 											label: "cl",
 											expr: &ruleRefExpr{
-												pos:  position{line: 50, col: 83, offset: 1510},
+												pos:  position{line: 50, col: 99, offset: 1526},
 												name: "Clause",
 											},
 										},
@@ -205,31 +213,87 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 50, col: 109, offset: 1536},
+						pos:  position{line: 50, col: 125, offset: 1552},
 						name: "DOT",
 					},
+					&labeledExpr{
+						pos:   position{line: 50, col: 129, offset: 1556},
+						label: "comment",
+						expr: &zeroOrOneExpr{
+							pos: position{line: 50, col: 137, offset: 1564},
+							expr: &ruleRefExpr{
+								pos:  position{line: 50, col: 137, offset: 1564},
+								name: "InlineComment",
+							},
+						},
+					},
 					&ruleRefExpr{
-						pos:  position{line: 50, col: 113, offset: 1540},
+						pos:  position{line: 50, col: 152, offset: 1579},
 						name: "RestOfLine",
 					},
 					&stateCodeExpr{
-						pos: position{line: 50, col: 124, offset: 1551},
-						run: (*parser).callonRecord16,
+						pos: position{line: 50, col: 163, offset: 1590},
+						run: (*parser).callonRecord21,
+					},
+				},
+			},
+		},
+		{
+			name: "RecordLine",
+			pos:  position{line: 54, col: 1, offset: 1782},
+			expr: &actionExpr{
+				pos: position{line: 54, col: 15, offset: 1796},
+				run: (*parser).callonRecordLine1,
+				expr: &andExpr{
+					pos: position{line: 54, col: 15, offset: 1796},
+					expr: &ruleRefExpr{
+						pos:  position{line: 54, col: 16, offset: 1797},
+						name: "Level",
+					},
+				},
+			},
+		},
+		{
+			name: "InlineComment",
+			pos:  position{line: 58, col: 1, offset: 1946},
+			expr: &actionExpr{
+				pos: position{line: 58, col: 18, offset: 1963},
+				run: (*parser).callonInlineComment1,
+				expr: &seqExpr{
+					pos: position{line: 58, col: 18, offset: 1963},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 58, col: 18, offset: 1963},
+							expr: &ruleRefExpr{
+								pos:  position{line: 58, col: 18, offset: 1963},
+								name: "Space",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 58, col: 25, offset: 1970},
+							val:        "*>",
+							ignoreCase: false,
+							want:       "\"*>\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 58, col: 30, offset: 1975},
+							name: "RestOfLine",
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "Level",
-			pos:  position{line: 53, col: 1, offset: 1643},
+			pos:  position{line: 61, col: 1, offset: 2033},
 			expr: &actionExpr{
-				pos: position{line: 53, col: 10, offset: 1652},
+				pos: position{line: 61, col: 10, offset: 2042},
 				run: (*parser).callonLevel1,
 				expr: &seqExpr{
-					pos: position{line: 53, col: 10, offset: 1652},
+					pos: position{line: 61, col: 10, offset: 2042},
 					exprs: []any{
 						&charClassMatcher{
-							pos:             position{line: 53, col: 10, offset: 1652},
+							pos:             position{line: 61, col: 10, offset: 2042},
 							val:             "[0-9]",
 							ranges:          []rune{'0', '9'},
 							basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
@@ -237,9 +301,9 @@ var g = &grammar{
 							inverted:        false,
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 53, col: 15, offset: 1657},
+							pos: position{line: 61, col: 15, offset: 2047},
 							expr: &charClassMatcher{
-								pos:             position{line: 53, col: 15, offset: 1657},
+								pos:             position{line: 61, col: 15, offset: 2047},
 								val:             "[0-9]",
 								ranges:          []rune{'0', '9'},
 								basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
@@ -253,29 +317,29 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 56, col: 1, offset: 1705},
+			pos:  position{line: 64, col: 1, offset: 2095},
 			expr: &actionExpr{
-				pos: position{line: 56, col: 15, offset: 1719},
+				pos: position{line: 64, col: 15, offset: 2109},
 				run: (*parser).callonIdentifier1,
 				expr: &seqExpr{
-					pos: position{line: 56, col: 15, offset: 1719},
+					pos: position{line: 64, col: 15, offset: 2109},
 					exprs: []any{
 						&andExpr{
-							pos: position{line: 56, col: 15, offset: 1719},
+							pos: position{line: 64, col: 15, offset: 2109},
 							expr: &ruleRefExpr{
-								pos:  position{line: 56, col: 16, offset: 1720},
+								pos:  position{line: 64, col: 16, offset: 2110},
 								name: "LetterCheck",
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 56, col: 28, offset: 1732},
+							pos: position{line: 64, col: 28, offset: 2122},
 							expr: &charClassMatcher{
-								pos:             position{line: 56, col: 28, offset: 1732},
-								val:             "[A-Z0-9-:]",
+								pos:             position{line: 64, col: 28, offset: 2122},
+								val:             "[A-Z0-9-:]i",
 								chars:           []rune{'-', ':'},
-								ranges:          []rune{'A', 'Z', '0', '9'},
-								basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, false, false, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
-								ignoreCase:      false,
+								ranges:          []rune{'a', 'z', '0', '9'},
+								basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, false, false, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false},
+								ignoreCase:      true,
 								inverted:        false,
 							},
 						},
@@ -285,14 +349,14 @@ var g = &grammar{
 		},
 		{
 			name: "LetterCheck",
-			pos:  position{line: 59, col: 1, offset: 1780},
+			pos:  position{line: 67, col: 1, offset: 2171},
 			expr: &seqExpr{
-				pos: position{line: 59, col: 16, offset: 1795},
+				pos: position{line: 67, col: 16, offset: 2186},
 				exprs: []any{
 					&zeroOrMoreExpr{
-						pos: position{line: 59, col: 16, offset: 1795},
+						pos: position{line: 67, col: 16, offset: 2186},
 						expr: &charClassMatcher{
-							pos:             position{line: 59, col: 16, offset: 1795},
+							pos:             position{line: 67, col: 16, offset: 2186},
 							val:             "[0-9-:]",
 							chars:           []rune{'-', ':'},
 							ranges:          []rune{'0', '9'},
@@ -302,11 +366,11 @@ var g = &grammar{
 						},
 					},
 					&charClassMatcher{
-						pos:             position{line: 59, col: 25, offset: 1804},
-						val:             "[A-Z]",
-						ranges:          []rune{'A', 'Z'},
-						basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
-						ignoreCase:      false,
+						pos:             position{line: 67, col: 25, offset: 2195},
+						val:             "[A-Z]i",
+						ranges:          []rune{'a', 'z'},
+						basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false},
+						ignoreCase:      true,
 						inverted:        false,
 					},
 				},
@@ -314,49 +378,69 @@ var g = &grammar{
 		},
 		{
 			name: "Clause",
-			pos:  position{line: 60, col: 1, offset: 1871},
+			pos:  position{line: 68, col: 1, offset: 2263},
 			expr: &choiceExpr{
-				pos: position{line: 60, col: 12, offset: 1882},
+				pos: position{line: 68, col: 12, offset: 2274},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 60, col: 12, offset: 1882},
+						pos:  position{line: 68, col: 12, offset: 2274},
 						name: "RedefinesClause",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 60, col: 30, offset: 1900},
+						pos:  position{line: 68, col: 30, offset: 2292},
 						name: "PictureClause",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 60, col: 46, offset: 1916},
+						pos:  position{line: 68, col: 46, offset: 2308},
 						name: "OccursClause",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 68, col: 61, offset: 2323},
+						name: "UsageClause",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 68, col: 75, offset: 2337},
+						name: "SynchronizedClause",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 68, col: 96, offset: 2358},
+						name: "JustifiedClause",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 68, col: 114, offset: 2376},
+						name: "BlankWhenZeroClause",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 68, col: 136, offset: 2398},
+						name: "ValueClause",
+					},
 				},
 			},
 		},
 		{
 			name: "RedefinesClause",
-			pos:  position{line: 64, col: 1, offset: 1943},
+			pos:  position{line: 73, col: 1, offset: 2513},
 			expr: &actionExpr{
-				pos: position{line: 64, col: 20, offset: 1962},
+				pos: position{line: 73, col: 20, offset: 2532},
 				run: (*parser).callonRedefinesClause1,
 				expr: &seqExpr{
-					pos: position{line: 64, col: 20, offset: 1962},
+					pos: position{line: 73, col: 20, offset: 2532},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 64, col: 20, offset: 1962},
-							val:        "REDEFINES",
-							ignoreCase: false,
-							want:       "\"REDEFINES\"",
+							pos:        position{line: 73, col: 20, offset: 2532},
+							val:        "redefines",
+							ignoreCase: true,
+							want:       "\"REDEFINES\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 64, col: 32, offset: 1974},
+							pos:  position{line: 73, col: 33, offset: 2545},
 							name: "SpacesOrEOLs",
 						},
 						&labeledExpr{
-							pos:   position{line: 64, col: 45, offset: 1987},
+							pos:   position{line: 73, col: 46, offset: 2558},
 							label: "identifier",
 							expr: &ruleRefExpr{
-								pos:  position{line: 64, col: 56, offset: 1998},
+								pos:  position{line: 73, col: 57, offset: 2569},
 								name: "Identifier",
 							},
 						},
@@ -366,59 +450,45 @@ var g = &grammar{
 		},
 		{
 			name: "PictureClause",
-			pos:  position{line: 68, col: 1, offset: 2063},
+			pos:  position{line: 77, col: 1, offset: 2634},
 			expr: &actionExpr{
-				pos: position{line: 68, col: 18, offset: 2080},
+				pos: position{line: 77, col: 18, offset: 2651},
 				run: (*parser).callonPictureClause1,
 				expr: &seqExpr{
-					pos: position{line: 68, col: 18, offset: 2080},
+					pos: position{line: 77, col: 18, offset: 2651},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 68, col: 18, offset: 2080},
+							pos:  position{line: 77, col: 18, offset: 2651},
 							name: "PicKeyword",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 68, col: 29, offset: 2091},
-							name: "Space",
-						},
-						&labeledExpr{
-							pos:   position{line: 68, col: 35, offset: 2097},
-							label: "picString",
-							expr: &ruleRefExpr{
-								pos:  position{line: 68, col: 45, offset: 2107},
-								name: "PicString",
-							},
+							pos:  position{line: 77, col: 29, offset: 2662},
+							name: "SpacesOrEOLs",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 68, col: 55, offset: 2117},
+							pos: position{line: 77, col: 42, offset: 2675},
 							expr: &seqExpr{
-								pos: position{line: 68, col: 56, offset: 2118},
+								pos: position{line: 77, col: 43, offset: 2676},
 								exprs: []any{
-									&ruleRefExpr{
-										pos:  position{line: 68, col: 56, offset: 2118},
-										name: "SpacesOrEOLs",
+									&litMatcher{
+										pos:        position{line: 77, col: 43, offset: 2676},
+										val:        "is",
+										ignoreCase: true,
+										want:       "\"IS\"i",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 68, col: 69, offset: 2131},
-										name: "Comp",
+										pos:  position{line: 77, col: 49, offset: 2682},
+										name: "SpacesOrEOLs",
 									},
 								},
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 68, col: 76, offset: 2138},
-							expr: &seqExpr{
-								pos: position{line: 68, col: 77, offset: 2139},
-								exprs: []any{
-									&ruleRefExpr{
-										pos:  position{line: 68, col: 77, offset: 2139},
-										name: "SpacesOrEOLs",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 68, col: 90, offset: 2152},
-										name: "Justified",
-									},
-								},
+						&labeledExpr{
+							pos:   position{line: 77, col: 64, offset: 2697},
+							label: "picString",
+							expr: &ruleRefExpr{
+								pos:  position{line: 77, col: 74, offset: 2707},
+								name: "PicString",
 							},
 						},
 					},
@@ -427,52 +497,52 @@ var g = &grammar{
 		},
 		{
 			name: "PicKeyword",
-			pos:  position{line: 71, col: 1, offset: 2214},
+			pos:  position{line: 80, col: 1, offset: 2767},
 			expr: &choiceExpr{
-				pos: position{line: 71, col: 15, offset: 2228},
+				pos: position{line: 80, col: 15, offset: 2781},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 71, col: 15, offset: 2228},
-						val:        "PICTURE",
-						ignoreCase: false,
-						want:       "\"PICTURE\"",
+						pos:        position{line: 80, col: 15, offset: 2781},
+						val:        "picture",
+						ignoreCase: true,
+						want:       "\"PICTURE\"i",
 					},
 					&litMatcher{
-						pos:        position{line: 71, col: 27, offset: 2240},
-						val:        "PIC",
-						ignoreCase: false,
-						want:       "\"PIC\"",
+						pos:        position{line: 80, col: 28, offset: 2794},
+						val:        "pic",
+						ignoreCase: true,
+						want:       "\"PIC\"i",
 					},
 				},
 			},
 		},
 		{
 			name: "PicString",
-			pos:  position{line: 72, col: 1, offset: 2246},
+			pos:  position{line: 81, col: 1, offset: 2801},
 			expr: &actionExpr{
-				pos: position{line: 72, col: 14, offset: 2259},
+				pos: position{line: 81, col: 14, offset: 2814},
 				run: (*parser).callonPicString1,
 				expr: &seqExpr{
-					pos: position{line: 72, col: 14, offset: 2259},
+					pos: position{line: 81, col: 14, offset: 2814},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 72, col: 14, offset: 2259},
+							pos:  position{line: 81, col: 14, offset: 2814},
 							name: "PicStartChar",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 72, col: 27, offset: 2272},
+							pos: position{line: 81, col: 27, offset: 2827},
 							expr: &seqExpr{
-								pos: position{line: 72, col: 28, offset: 2273},
+								pos: position{line: 81, col: 28, offset: 2828},
 								exprs: []any{
 									&notExpr{
-										pos: position{line: 72, col: 28, offset: 2273},
+										pos: position{line: 81, col: 28, offset: 2828},
 										expr: &ruleRefExpr{
-											pos:  position{line: 72, col: 29, offset: 2274},
+											pos:  position{line: 81, col: 29, offset: 2829},
 											name: "PicEnd",
 										},
 									},
 									&anyMatcher{
-										line: 72, col: 36, offset: 2281,
+										line: 81, col: 36, offset: 2836,
 									},
 								},
 							},
@@ -483,163 +553,299 @@ var g = &grammar{
 		},
 		{
 			name: "PicStartChar",
-			pos:  position{line: 75, col: 1, offset: 2320},
+			pos:  position{line: 84, col: 1, offset: 2875},
 			expr: &charClassMatcher{
-				pos:             position{line: 75, col: 17, offset: 2336},
-				val:             "[X9ASVP]",
-				chars:           []rune{'X', '9', 'A', 'S', 'V', 'P'},
-				basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, false, false, false, false, false, false, false, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, false, false, true, false, false, true, false, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
-				ignoreCase:      false,
+				pos:             position{line: 84, col: 17, offset: 2891},
+				val:             "[X9ASVPNGZ*$+B0/.-]i",
+				chars:           []rune{'x', '9', 'a', 's', 'v', 'p', 'n', 'g', 'z', '*', '$', '+', 'b', '0', '/', '.', '-'},
+				basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, false, false, false, false, false, true, true, false, true, true, true, true, false, false, false, false, false, false, false, false, true, false, false, false, false, false, false, false, true, true, false, false, false, false, true, false, false, false, false, false, false, true, false, true, false, false, true, false, false, true, false, true, false, true, false, false, false, false, false, false, true, true, false, false, false, false, true, false, false, false, false, false, false, true, false, true, false, false, true, false, false, true, false, true, false, true, false, false, false, false, false},
+				ignoreCase:      true,
 				inverted:        false,
 			},
 		},
 		{
 			name: "PicEnd",
-			pos:  position{line: 76, col: 1, offset: 2345},
+			pos:  position{line: 85, col: 1, offset: 2912},
 			expr: &seqExpr{
-				pos: position{line: 76, col: 11, offset: 2355},
+				pos: position{line: 85, col: 11, offset: 2922},
 				exprs: []any{
 					&zeroOrOneExpr{
-						pos: position{line: 76, col: 11, offset: 2355},
+						pos: position{line: 85, col: 11, offset: 2922},
 						expr: &ruleRefExpr{
-							pos:  position{line: 76, col: 11, offset: 2355},
+							pos:  position{line: 85, col: 11, offset: 2922},
 							name: "DOT",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 76, col: 16, offset: 2360},
+						pos:  position{line: 85, col: 16, offset: 2927},
 						name: "Space",
 					},
 				},
 			},
 		},
 		{
-			name: "Comp",
-			pos:  position{line: 77, col: 1, offset: 2366},
-			expr: &choiceExpr{
-				pos: position{line: 77, col: 10, offset: 2375},
-				alternatives: []any{
-					&litMatcher{
-						pos:        position{line: 77, col: 10, offset: 2375},
-						val:        "COMP-5",
-						ignoreCase: false,
-						want:       "\"COMP-5\"",
-					},
-					&litMatcher{
-						pos:        position{line: 77, col: 21, offset: 2386},
-						val:        "COMP-4",
-						ignoreCase: false,
-						want:       "\"COMP-4\"",
-					},
-					&litMatcher{
-						pos:        position{line: 77, col: 32, offset: 2397},
-						val:        "COMP-3",
-						ignoreCase: false,
-						want:       "\"COMP-3\"",
-					},
-					&litMatcher{
-						pos:        position{line: 77, col: 43, offset: 2408},
-						val:        "COMP-2",
-						ignoreCase: false,
-						want:       "\"COMP-2\"",
-					},
-					&litMatcher{
-						pos:        position{line: 77, col: 54, offset: 2419},
-						val:        "COMP-1",
-						ignoreCase: false,
-						want:       "\"COMP-1\"",
+			name: "UsageClause",
+			pos:  position{line: 87, col: 1, offset: 2934},
+			expr: &actionExpr{
+				pos: position{line: 87, col: 16, offset: 2949},
+				run: (*parser).callonUsageClause1,
+				expr: &seqExpr{
+					pos: position{line: 87, col: 16, offset: 2949},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 87, col: 16, offset: 2949},
+							expr: &seqExpr{
+								pos: position{line: 87, col: 17, offset: 2950},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 87, col: 17, offset: 2950},
+										val:        "usage",
+										ignoreCase: true,
+										want:       "\"USAGE\"i",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 87, col: 26, offset: 2959},
+										name: "SpacesOrEOLs",
+									},
+									&zeroOrOneExpr{
+										pos: position{line: 87, col: 39, offset: 2972},
+										expr: &seqExpr{
+											pos: position{line: 87, col: 40, offset: 2973},
+											exprs: []any{
+												&litMatcher{
+													pos:        position{line: 87, col: 40, offset: 2973},
+													val:        "is",
+													ignoreCase: true,
+													want:       "\"IS\"i",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 87, col: 46, offset: 2979},
+													name: "SpacesOrEOLs",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 87, col: 63, offset: 2996},
+							label: "usage",
+							expr: &ruleRefExpr{
+								pos:  position{line: 87, col: 69, offset: 3002},
+								name: "UsageKeyword",
+							},
+						},
 					},
-					&litMatcher{
-						pos:        position{line: 77, col: 65, offset: 2430},
-						val:        "COMP",
-						ignoreCase: false,
-						want:       "\"COMP\"",
+				},
+			},
+		},
+		{
+			name: "UsageKeyword",
+			pos:  position{line: 91, col: 1, offset: 3151},
+			expr: &actionExpr{
+				pos: position{line: 91, col: 17, offset: 3167},
+				run: (*parser).callonUsageKeyword1,
+				expr: &choiceExpr{
+					pos: position{line: 91, col: 18, offset: 3168},
+					alternatives: []any{
+						&ruleRefExpr{
+							pos:  position{line: 91, col: 18, offset: 3168},
+							name: "ComputationalKeyword",
+						},
+						&litMatcher{
+							pos:        position{line: 91, col: 41, offset: 3191},
+							val:        "binary",
+							ignoreCase: true,
+							want:       "\"BINARY\"i",
+						},
+						&litMatcher{
+							pos:        position{line: 91, col: 53, offset: 3203},
+							val:        "packed-decimal",
+							ignoreCase: true,
+							want:       "\"PACKED-DECIMAL\"i",
+						},
+						&litMatcher{
+							pos:        position{line: 91, col: 73, offset: 3223},
+							val:        "display",
+							ignoreCase: true,
+							want:       "\"DISPLAY\"i",
+						},
+						&litMatcher{
+							pos:        position{line: 91, col: 86, offset: 3236},
+							val:        "procedure-pointer",
+							ignoreCase: true,
+							want:       "\"PROCEDURE-POINTER\"i",
+						},
+						&litMatcher{
+							pos:        position{line: 91, col: 109, offset: 3259},
+							val:        "function-pointer",
+							ignoreCase: true,
+							want:       "\"FUNCTION-POINTER\"i",
+						},
+						&litMatcher{
+							pos:        position{line: 91, col: 131, offset: 3281},
+							val:        "pointer",
+							ignoreCase: true,
+							want:       "\"POINTER\"i",
+						},
+						&litMatcher{
+							pos:        position{line: 91, col: 144, offset: 3294},
+							val:        "index",
+							ignoreCase: true,
+							want:       "\"INDEX\"i",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "Justified",
-			pos:  position{line: 78, col: 1, offset: 2495},
+			name: "ComputationalKeyword",
+			pos:  position{line: 94, col: 1, offset: 3339},
 			expr: &seqExpr{
-				pos: position{line: 78, col: 14, offset: 2508},
+				pos: position{line: 94, col: 25, offset: 3363},
 				exprs: []any{
-					&litMatcher{
-						pos:        position{line: 78, col: 14, offset: 2508},
-						val:        "JUSTIFIED",
-						ignoreCase: false,
-						want:       "\"JUSTIFIED\"",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 78, col: 26, offset: 2520},
-						name: "SpacesOrEOLs",
+					&choiceExpr{
+						pos: position{line: 94, col: 26, offset: 3364},
+						alternatives: []any{
+							&litMatcher{
+								pos:        position{line: 94, col: 26, offset: 3364},
+								val:        "computational",
+								ignoreCase: true,
+								want:       "\"COMPUTATIONAL\"i",
+							},
+							&litMatcher{
+								pos:        position{line: 94, col: 45, offset: 3383},
+								val:        "comp",
+								ignoreCase: true,
+								want:       "\"COMP\"i",
+							},
+						},
 					},
-					&litMatcher{
-						pos:        position{line: 78, col: 39, offset: 2533},
-						val:        "RIGHT",
-						ignoreCase: false,
-						want:       "\"RIGHT\"",
+					&zeroOrOneExpr{
+						pos: position{line: 94, col: 54, offset: 3392},
+						expr: &seqExpr{
+							pos: position{line: 94, col: 55, offset: 3393},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 94, col: 55, offset: 3393},
+									val:        "-",
+									ignoreCase: false,
+									want:       "\"-\"",
+								},
+								&charClassMatcher{
+									pos:             position{line: 94, col: 59, offset: 3397},
+									val:             "[1-5]",
+									ranges:          []rune{'1', '5'},
+									basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+									ignoreCase:      false,
+									inverted:        false,
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "OccursClause",
-			pos:  position{line: 80, col: 1, offset: 2613},
+			name: "SynchronizedClause",
+			pos:  position{line: 96, col: 1, offset: 3406},
 			expr: &actionExpr{
-				pos: position{line: 80, col: 17, offset: 2629},
-				run: (*parser).callonOccursClause1,
+				pos: position{line: 96, col: 23, offset: 3428},
+				run: (*parser).callonSynchronizedClause1,
 				expr: &seqExpr{
-					pos: position{line: 80, col: 17, offset: 2629},
+					pos: position{line: 96, col: 23, offset: 3428},
 					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 80, col: 17, offset: 2629},
-							val:        "OCCURS",
-							ignoreCase: false,
-							want:       "\"OCCURS\"",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 80, col: 26, offset: 2638},
-							name: "SpacesOrEOLs",
-						},
-						&labeledExpr{
-							pos:   position{line: 80, col: 39, offset: 2651},
-							label: "count",
-							expr: &ruleRefExpr{
-								pos:  position{line: 80, col: 45, offset: 2657},
-								name: "Count",
+						&choiceExpr{
+							pos: position{line: 96, col: 24, offset: 3429},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 96, col: 24, offset: 3429},
+									val:        "synchronized",
+									ignoreCase: true,
+									want:       "\"SYNCHRONIZED\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 96, col: 42, offset: 3447},
+									val:        "sync",
+									ignoreCase: true,
+									want:       "\"SYNC\"i",
+								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 80, col: 51, offset: 2663},
+							pos: position{line: 96, col: 51, offset: 3456},
 							expr: &seqExpr{
-								pos: position{line: 80, col: 52, offset: 2664},
+								pos: position{line: 96, col: 52, offset: 3457},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 80, col: 52, offset: 2664},
+										pos:  position{line: 96, col: 52, offset: 3457},
 										name: "SpacesOrEOLs",
 									},
-									&litMatcher{
-										pos:        position{line: 80, col: 65, offset: 2677},
-										val:        "TIMES",
-										ignoreCase: false,
-										want:       "\"TIMES\"",
+									&choiceExpr{
+										pos: position{line: 96, col: 66, offset: 3471},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 96, col: 66, offset: 3471},
+												val:        "left",
+												ignoreCase: true,
+												want:       "\"LEFT\"i",
+											},
+											&litMatcher{
+												pos:        position{line: 96, col: 76, offset: 3481},
+												val:        "right",
+												ignoreCase: true,
+												want:       "\"RIGHT\"i",
+											},
+										},
 									},
 								},
 							},
 						},
+					},
+				},
+			},
+		},
+		{
+			name: "JustifiedClause",
+			pos:  position{line: 100, col: 1, offset: 3540},
+			expr: &actionExpr{
+				pos: position{line: 100, col: 20, offset: 3559},
+				run: (*parser).callonJustifiedClause1,
+				expr: &seqExpr{
+					pos: position{line: 100, col: 20, offset: 3559},
+					exprs: []any{
+						&choiceExpr{
+							pos: position{line: 100, col: 21, offset: 3560},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 100, col: 21, offset: 3560},
+									val:        "justified",
+									ignoreCase: true,
+									want:       "\"JUSTIFIED\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 100, col: 36, offset: 3575},
+									val:        "just",
+									ignoreCase: true,
+									want:       "\"JUST\"i",
+								},
+							},
+						},
 						&zeroOrOneExpr{
-							pos: position{line: 80, col: 75, offset: 2687},
+							pos: position{line: 100, col: 45, offset: 3584},
 							expr: &seqExpr{
-								pos: position{line: 80, col: 76, offset: 2688},
+								pos: position{line: 100, col: 46, offset: 3585},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 80, col: 76, offset: 2688},
+										pos:  position{line: 100, col: 46, offset: 3585},
 										name: "SpacesOrEOLs",
 									},
-									&ruleRefExpr{
-										pos:  position{line: 80, col: 89, offset: 2701},
-										name: "IndexedBy",
+									&litMatcher{
+										pos:        position{line: 100, col: 59, offset: 3598},
+										val:        "right",
+										ignoreCase: true,
+										want:       "\"RIGHT\"i",
 									},
 								},
 							},
@@ -649,64 +855,1000 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "Count",
-			pos:  position{line: 83, col: 1, offset: 2758},
+			name: "BlankWhenZeroClause",
+			pos:  position{line: 104, col: 1, offset: 3653},
 			expr: &actionExpr{
-				pos: position{line: 83, col: 10, offset: 2767},
-				run: (*parser).callonCount1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 83, col: 10, offset: 2767},
-					expr: &charClassMatcher{
-						pos:             position{line: 83, col: 10, offset: 2767},
-						val:             "[0-9]",
-						ranges:          []rune{'0', '9'},
-						basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
-						ignoreCase:      false,
-						inverted:        false,
+				pos: position{line: 104, col: 24, offset: 3676},
+				run: (*parser).callonBlankWhenZeroClause1,
+				expr: &seqExpr{
+					pos: position{line: 104, col: 24, offset: 3676},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 104, col: 24, offset: 3676},
+							val:        "blank",
+							ignoreCase: true,
+							want:       "\"BLANK\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 104, col: 33, offset: 3685},
+							name: "SpacesOrEOLs",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 104, col: 46, offset: 3698},
+							expr: &seqExpr{
+								pos: position{line: 104, col: 47, offset: 3699},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 104, col: 47, offset: 3699},
+										val:        "when",
+										ignoreCase: true,
+										want:       "\"WHEN\"i",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 104, col: 55, offset: 3707},
+										name: "SpacesOrEOLs",
+									},
+								},
+							},
+						},
+						&choiceExpr{
+							pos: position{line: 104, col: 71, offset: 3723},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 104, col: 71, offset: 3723},
+									val:        "zeroes",
+									ignoreCase: true,
+									want:       "\"ZEROES\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 104, col: 83, offset: 3735},
+									val:        "zeros",
+									ignoreCase: true,
+									want:       "\"ZEROS\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 104, col: 94, offset: 3746},
+									val:        "zero",
+									ignoreCase: true,
+									want:       "\"ZERO\"i",
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "IndexedBy",
-			pos:  position{line: 86, col: 1, offset: 2815},
-			expr: &seqExpr{
-				pos: position{line: 86, col: 14, offset: 2828},
-				exprs: []any{
-					&litMatcher{
-						pos:        position{line: 86, col: 14, offset: 2828},
-						val:        "INDEXED BY",
-						ignoreCase: false,
-						want:       "\"INDEXED BY\"",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 86, col: 27, offset: 2841},
-						name: "SpacesOrEOLs",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 86, col: 40, offset: 2854},
-						name: "Identifier",
+			name: "ValueClause",
+			pos:  position{line: 109, col: 1, offset: 3899},
+			expr: &actionExpr{
+				pos: position{line: 109, col: 16, offset: 3914},
+				run: (*parser).callonValueClause1,
+				expr: &seqExpr{
+					pos: position{line: 109, col: 16, offset: 3914},
+					exprs: []any{
+						&choiceExpr{
+							pos: position{line: 109, col: 17, offset: 3915},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 109, col: 17, offset: 3915},
+									val:        "values",
+									ignoreCase: true,
+									want:       "\"VALUES\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 109, col: 29, offset: 3927},
+									val:        "value",
+									ignoreCase: true,
+									want:       "\"VALUE\"i",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 109, col: 39, offset: 3937},
+							name: "SpacesOrEOLs",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 109, col: 52, offset: 3950},
+							expr: &seqExpr{
+								pos: position{line: 109, col: 53, offset: 3951},
+								exprs: []any{
+									&choiceExpr{
+										pos: position{line: 109, col: 54, offset: 3952},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 109, col: 54, offset: 3952},
+												val:        "is",
+												ignoreCase: true,
+												want:       "\"IS\"i",
+											},
+											&litMatcher{
+												pos:        position{line: 109, col: 62, offset: 3960},
+												val:        "are",
+												ignoreCase: true,
+												want:       "\"ARE\"i",
+											},
+										},
+									},
+									&ruleRefExpr{
+										pos:  position{line: 109, col: 70, offset: 3968},
+										name: "SpacesOrEOLs",
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 109, col: 85, offset: 3983},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 109, col: 91, offset: 3989},
+								name: "ValueRange",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 109, col: 102, offset: 4000},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 109, col: 107, offset: 4005},
+								expr: &actionExpr{
+									pos: position{line: 109, col: 108, offset: 4006},
+									run: (*parser).callonValueClause17,
+									expr: &seqExpr{
+										pos: position{line: 109, col: 108, offset: 4006},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 109, col: 108, offset: 4006},
+												name: "SpacesOrEOLs",
+											},
+											&labeledExpr{
+												pos:   position{line: 109, col: 121, offset: 4019},
+												label: "r",
+												expr: &ruleRefExpr{
+													pos:  position{line: 109, col: 123, offset: 4021},
+													name: "ValueRange",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "DOT",
-			pos:  position{line: 90, col: 1, offset: 2940},
-			expr: &litMatcher{
-				pos:        position{line: 90, col: 8, offset: 2947},
-				val:        ".",
+			name: "ValueRange",
+			pos:  position{line: 112, col: 1, offset: 4100},
+			expr: &actionExpr{
+				pos: position{line: 112, col: 15, offset: 4114},
+				run: (*parser).callonValueRange1,
+				expr: &seqExpr{
+					pos: position{line: 112, col: 15, offset: 4114},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 112, col: 15, offset: 4114},
+							label: "from",
+							expr: &ruleRefExpr{
+								pos:  position{line: 112, col: 20, offset: 4119},
+								name: "Literal",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 112, col: 28, offset: 4127},
+							label: "through",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 112, col: 36, offset: 4135},
+								expr: &actionExpr{
+									pos: position{line: 112, col: 37, offset: 4136},
+									run: (*parser).callonValueRange7,
+									expr: &seqExpr{
+										pos: position{line: 112, col: 37, offset: 4136},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 112, col: 37, offset: 4136},
+												name: "SpacesOrEOLs",
+											},
+											&choiceExpr{
+												pos: position{line: 112, col: 51, offset: 4150},
+												alternatives: []any{
+													&litMatcher{
+														pos:        position{line: 112, col: 51, offset: 4150},
+														val:        "through",
+														ignoreCase: true,
+														want:       "\"THROUGH\"i",
+													},
+													&litMatcher{
+														pos:        position{line: 112, col: 64, offset: 4163},
+														val:        "thru",
+														ignoreCase: true,
+														want:       "\"THRU\"i",
+													},
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 112, col: 73, offset: 4172},
+												name: "SpacesOrEOLs",
+											},
+											&labeledExpr{
+												pos:   position{line: 112, col: 86, offset: 4185},
+												label: "l",
+												expr: &ruleRefExpr{
+													pos:  position{line: 112, col: 88, offset: 4187},
+													name: "Literal",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Literal",
+			pos:  position{line: 115, col: 1, offset: 4264},
+			expr: &choiceExpr{
+				pos: position{line: 115, col: 12, offset: 4275},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 115, col: 12, offset: 4275},
+						name: "AlphanumericLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 115, col: 34, offset: 4297},
+						name: "FigurativeConstant",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 115, col: 55, offset: 4318},
+						name: "NumericLiteral",
+					},
+				},
+			},
+		},
+		{
+			name: "AlphanumericLiteral",
+			pos:  position{line: 117, col: 1, offset: 4423},
+			expr: &actionExpr{
+				pos: position{line: 117, col: 24, offset: 4446},
+				run: (*parser).callonAlphanumericLiteral1,
+				expr: &choiceExpr{
+					pos: position{line: 117, col: 25, offset: 4447},
+					alternatives: []any{
+						&seqExpr{
+							pos: position{line: 117, col: 25, offset: 4447},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 117, col: 25, offset: 4447},
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 117, col: 29, offset: 4451},
+									expr: &choiceExpr{
+										pos: position{line: 117, col: 30, offset: 4452},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 117, col: 30, offset: 4452},
+												val:        "\"\"",
+												ignoreCase: false,
+												want:       "\"\\\"\\\"\"",
+											},
+											&charClassMatcher{
+												pos:             position{line: 117, col: 39, offset: 4461},
+												val:             "[^\"\\n\\r]",
+												chars:           []rune{'"', '\n', '\r'},
+												basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, true, false, false, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+												ignoreCase:      false,
+												inverted:        true,
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 117, col: 50, offset: 4472},
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
+								},
+							},
+						},
+						&seqExpr{
+							pos: position{line: 117, col: 56, offset: 4478},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 117, col: 56, offset: 4478},
+									val:        "'",
+									ignoreCase: false,
+									want:       "\"'\"",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 117, col: 60, offset: 4482},
+									expr: &choiceExpr{
+										pos: position{line: 117, col: 61, offset: 4483},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 117, col: 61, offset: 4483},
+												val:        "''",
+												ignoreCase: false,
+												want:       "\"''\"",
+											},
+											&charClassMatcher{
+												pos:             position{line: 117, col: 68, offset: 4490},
+												val:             "[^'\\n\\r]",
+												chars:           []rune{'\'', '\n', '\r'},
+												basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, true, false, false, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+												ignoreCase:      false,
+												inverted:        true,
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 117, col: 79, offset: 4501},
+									val:        "'",
+									ignoreCase: false,
+									want:       "\"'\"",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FigurativeConstant",
+			pos:  position{line: 120, col: 1, offset: 4559},
+			expr: &actionExpr{
+				pos: position{line: 120, col: 23, offset: 4581},
+				run: (*parser).callonFigurativeConstant1,
+				expr: &seqExpr{
+					pos: position{line: 120, col: 23, offset: 4581},
+					exprs: []any{
+						&choiceExpr{
+							pos: position{line: 120, col: 24, offset: 4582},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 120, col: 24, offset: 4582},
+									val:        "zeroes",
+									ignoreCase: true,
+									want:       "\"ZEROES\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 120, col: 36, offset: 4594},
+									val:        "zeros",
+									ignoreCase: true,
+									want:       "\"ZEROS\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 120, col: 47, offset: 4605},
+									val:        "zero",
+									ignoreCase: true,
+									want:       "\"ZERO\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 120, col: 57, offset: 4615},
+									val:        "spaces",
+									ignoreCase: true,
+									want:       "\"SPACES\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 120, col: 69, offset: 4627},
+									val:        "space",
+									ignoreCase: true,
+									want:       "\"SPACE\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 120, col: 80, offset: 4638},
+									val:        "high-values",
+									ignoreCase: true,
+									want:       "\"HIGH-VALUES\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 120, col: 97, offset: 4655},
+									val:        "high-value",
+									ignoreCase: true,
+									want:       "\"HIGH-VALUE\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 120, col: 113, offset: 4671},
+									val:        "low-values",
+									ignoreCase: true,
+									want:       "\"LOW-VALUES\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 120, col: 129, offset: 4687},
+									val:        "low-value",
+									ignoreCase: true,
+									want:       "\"LOW-VALUE\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 120, col: 144, offset: 4702},
+									val:        "quotes",
+									ignoreCase: true,
+									want:       "\"QUOTES\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 120, col: 156, offset: 4714},
+									val:        "quote",
+									ignoreCase: true,
+									want:       "\"QUOTE\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 120, col: 167, offset: 4725},
+									val:        "nulls",
+									ignoreCase: true,
+									want:       "\"NULLS\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 120, col: 178, offset: 4736},
+									val:        "null",
+									ignoreCase: true,
+									want:       "\"NULL\"i",
+								},
+							},
+						},
+						&notExpr{
+							pos: position{line: 120, col: 187, offset: 4745},
+							expr: &charClassMatcher{
+								pos:             position{line: 120, col: 188, offset: 4746},
+								val:             "[A-Z0-9-]i",
+								chars:           []rune{'-'},
+								ranges:          []rune{'a', 'z', '0', '9'},
+								basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, false, false, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false},
+								ignoreCase:      true,
+								inverted:        false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NumericLiteral",
+			pos:  position{line: 123, col: 1, offset: 4809},
+			expr: &actionExpr{
+				pos: position{line: 123, col: 19, offset: 4827},
+				run: (*parser).callonNumericLiteral1,
+				expr: &seqExpr{
+					pos: position{line: 123, col: 19, offset: 4827},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 123, col: 19, offset: 4827},
+							expr: &charClassMatcher{
+								pos:             position{line: 123, col: 19, offset: 4827},
+								val:             "[+-]",
+								chars:           []rune{'+', '-'},
+								basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, false, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+								ignoreCase:      false,
+								inverted:        false,
+							},
+						},
+						&choiceExpr{
+							pos: position{line: 123, col: 26, offset: 4834},
+							alternatives: []any{
+								&seqExpr{
+									pos: position{line: 123, col: 26, offset: 4834},
+									exprs: []any{
+										&zeroOrMoreExpr{
+											pos: position{line: 123, col: 26, offset: 4834},
+											expr: &charClassMatcher{
+												pos:             position{line: 123, col: 26, offset: 4834},
+												val:             "[0-9]",
+												ranges:          []rune{'0', '9'},
+												basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+												ignoreCase:      false,
+												inverted:        false,
+											},
+										},
+										&litMatcher{
+											pos:        position{line: 123, col: 33, offset: 4841},
+											val:        ".",
+											ignoreCase: false,
+											want:       "\".\"",
+										},
+										&oneOrMoreExpr{
+											pos: position{line: 123, col: 37, offset: 4845},
+											expr: &charClassMatcher{
+												pos:             position{line: 123, col: 37, offset: 4845},
+												val:             "[0-9]",
+												ranges:          []rune{'0', '9'},
+												basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+												ignoreCase:      false,
+												inverted:        false,
+											},
+										},
+									},
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 123, col: 46, offset: 4854},
+									expr: &charClassMatcher{
+										pos:             position{line: 123, col: 46, offset: 4854},
+										val:             "[0-9]",
+										ranges:          []rune{'0', '9'},
+										basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+										ignoreCase:      false,
+										inverted:        false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OccursClause",
+			pos:  position{line: 127, col: 1, offset: 4911},
+			expr: &actionExpr{
+				pos: position{line: 127, col: 17, offset: 4927},
+				run: (*parser).callonOccursClause1,
+				expr: &seqExpr{
+					pos: position{line: 127, col: 17, offset: 4927},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 127, col: 17, offset: 4927},
+							val:        "occurs",
+							ignoreCase: true,
+							want:       "\"OCCURS\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 127, col: 27, offset: 4937},
+							name: "SpacesOrEOLs",
+						},
+						&labeledExpr{
+							pos:   position{line: 127, col: 40, offset: 4950},
+							label: "count",
+							expr: &ruleRefExpr{
+								pos:  position{line: 127, col: 46, offset: 4956},
+								name: "Count",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 127, col: 52, offset: 4962},
+							expr: &seqExpr{
+								pos: position{line: 127, col: 53, offset: 4963},
+								exprs: []any{
+									&ruleRefExpr{
+										pos:  position{line: 127, col: 53, offset: 4963},
+										name: "SpacesOrEOLs",
+									},
+									&litMatcher{
+										pos:        position{line: 127, col: 66, offset: 4976},
+										val:        "times",
+										ignoreCase: true,
+										want:       "\"TIMES\"i",
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 127, col: 77, offset: 4987},
+							label: "keys",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 127, col: 82, offset: 4992},
+								expr: &actionExpr{
+									pos: position{line: 127, col: 83, offset: 4993},
+									run: (*parser).callonOccursClause13,
+									expr: &seqExpr{
+										pos: position{line: 127, col: 83, offset: 4993},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 127, col: 83, offset: 4993},
+												name: "SpacesOrEOLs",
+											},
+											&labeledExpr{
+												pos:   position{line: 127, col: 96, offset: 5006},
+												label: "key",
+												expr: &ruleRefExpr{
+													pos:  position{line: 127, col: 100, offset: 5010},
+													name: "KeyPhrase",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 127, col: 130, offset: 5040},
+							label: "indexes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 127, col: 138, offset: 5048},
+								expr: &actionExpr{
+									pos: position{line: 127, col: 139, offset: 5049},
+									run: (*parser).callonOccursClause20,
+									expr: &seqExpr{
+										pos: position{line: 127, col: 139, offset: 5049},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 127, col: 139, offset: 5049},
+												name: "SpacesOrEOLs",
+											},
+											&labeledExpr{
+												pos:   position{line: 127, col: 152, offset: 5062},
+												label: "index",
+												expr: &ruleRefExpr{
+													pos:  position{line: 127, col: 158, offset: 5068},
+													name: "IndexedBy",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Count",
+			pos:  position{line: 130, col: 1, offset: 5160},
+			expr: &actionExpr{
+				pos: position{line: 130, col: 10, offset: 5169},
+				run: (*parser).callonCount1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 130, col: 10, offset: 5169},
+					expr: &charClassMatcher{
+						pos:             position{line: 130, col: 10, offset: 5169},
+						val:             "[0-9]",
+						ranges:          []rune{'0', '9'},
+						basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
+						ignoreCase:      false,
+						inverted:        false,
+					},
+				},
+			},
+		},
+		{
+			name: "KeyPhrase",
+			pos:  position{line: 133, col: 1, offset: 5217},
+			expr: &actionExpr{
+				pos: position{line: 133, col: 14, offset: 5230},
+				run: (*parser).callonKeyPhrase1,
+				expr: &seqExpr{
+					pos: position{line: 133, col: 14, offset: 5230},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 133, col: 14, offset: 5230},
+							label: "order",
+							expr: &choiceExpr{
+								pos: position{line: 133, col: 21, offset: 5237},
+								alternatives: []any{
+									&litMatcher{
+										pos:        position{line: 133, col: 21, offset: 5237},
+										val:        "ascending",
+										ignoreCase: true,
+										want:       "\"ASCENDING\"i",
+									},
+									&litMatcher{
+										pos:        position{line: 133, col: 36, offset: 5252},
+										val:        "descending",
+										ignoreCase: true,
+										want:       "\"DESCENDING\"i",
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 133, col: 51, offset: 5267},
+							name: "SpacesOrEOLs",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 133, col: 64, offset: 5280},
+							expr: &seqExpr{
+								pos: position{line: 133, col: 65, offset: 5281},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 133, col: 65, offset: 5281},
+										val:        "key",
+										ignoreCase: true,
+										want:       "\"KEY\"i",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 133, col: 72, offset: 5288},
+										name: "SpacesOrEOLs",
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 133, col: 87, offset: 5303},
+							expr: &seqExpr{
+								pos: position{line: 133, col: 88, offset: 5304},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 133, col: 88, offset: 5304},
+										val:        "is",
+										ignoreCase: true,
+										want:       "\"IS\"i",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 133, col: 94, offset: 5310},
+										name: "SpacesOrEOLs",
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 133, col: 109, offset: 5325},
+							label: "names",
+							expr: &ruleRefExpr{
+								pos:  position{line: 133, col: 115, offset: 5331},
+								name: "NameList",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IndexedBy",
+			pos:  position{line: 136, col: 1, offset: 5389},
+			expr: &actionExpr{
+				pos: position{line: 136, col: 14, offset: 5402},
+				run: (*parser).callonIndexedBy1,
+				expr: &seqExpr{
+					pos: position{line: 136, col: 14, offset: 5402},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 136, col: 14, offset: 5402},
+							val:        "indexed",
+							ignoreCase: true,
+							want:       "\"INDEXED\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 136, col: 25, offset: 5413},
+							name: "SpacesOrEOLs",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 136, col: 38, offset: 5426},
+							expr: &seqExpr{
+								pos: position{line: 136, col: 39, offset: 5427},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 136, col: 39, offset: 5427},
+										val:        "by",
+										ignoreCase: true,
+										want:       "\"BY\"i",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 136, col: 45, offset: 5433},
+										name: "SpacesOrEOLs",
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 136, col: 60, offset: 5448},
+							label: "names",
+							expr: &ruleRefExpr{
+								pos:  position{line: 136, col: 66, offset: 5454},
+								name: "NameList",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NameList",
+			pos:  position{line: 140, col: 1, offset: 5583},
+			expr: &actionExpr{
+				pos: position{line: 140, col: 13, offset: 5595},
+				run: (*parser).callonNameList1,
+				expr: &seqExpr{
+					pos: position{line: 140, col: 13, offset: 5595},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 140, col: 13, offset: 5595},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 140, col: 19, offset: 5601},
+								name: "ListedName",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 140, col: 30, offset: 5612},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 140, col: 35, offset: 5617},
+								expr: &actionExpr{
+									pos: position{line: 140, col: 36, offset: 5618},
+									run: (*parser).callonNameList7,
+									expr: &seqExpr{
+										pos: position{line: 140, col: 36, offset: 5618},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 140, col: 36, offset: 5618},
+												name: "SpacesOrEOLs",
+											},
+											&labeledExpr{
+												pos:   position{line: 140, col: 49, offset: 5631},
+												label: "name",
+												expr: &ruleRefExpr{
+													pos:  position{line: 140, col: 54, offset: 5636},
+													name: "ListedName",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ListedName",
+			pos:  position{line: 143, col: 1, offset: 5715},
+			expr: &actionExpr{
+				pos: position{line: 143, col: 15, offset: 5729},
+				run: (*parser).callonListedName1,
+				expr: &seqExpr{
+					pos: position{line: 143, col: 15, offset: 5729},
+					exprs: []any{
+						&notExpr{
+							pos: position{line: 143, col: 15, offset: 5729},
+							expr: &seqExpr{
+								pos: position{line: 143, col: 17, offset: 5731},
+								exprs: []any{
+									&ruleRefExpr{
+										pos:  position{line: 143, col: 17, offset: 5731},
+										name: "ListTerminator",
+									},
+									&notExpr{
+										pos: position{line: 143, col: 32, offset: 5746},
+										expr: &charClassMatcher{
+											pos:             position{line: 143, col: 33, offset: 5747},
+											val:             "[A-Z0-9:-]i",
+											chars:           []rune{':', '-'},
+											ranges:          []rune{'a', 'z', '0', '9'},
+											basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, false, false, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false, false, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, false, false, false, false, false},
+											ignoreCase:      true,
+											inverted:        false,
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 143, col: 46, offset: 5760},
+							label: "identifier",
+							expr: &ruleRefExpr{
+								pos:  position{line: 143, col: 57, offset: 5771},
+								name: "Identifier",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ListTerminator",
+			pos:  position{line: 146, col: 1, offset: 5813},
+			expr: &choiceExpr{
+				pos: position{line: 146, col: 19, offset: 5831},
+				alternatives: []any{
+					&litMatcher{
+						pos:        position{line: 146, col: 19, offset: 5831},
+						val:        "values",
+						ignoreCase: true,
+						want:       "\"VALUES\"i",
+					},
+					&litMatcher{
+						pos:        position{line: 146, col: 31, offset: 5843},
+						val:        "value",
+						ignoreCase: true,
+						want:       "\"VALUE\"i",
+					},
+					&litMatcher{
+						pos:        position{line: 146, col: 42, offset: 5854},
+						val:        "ascending",
+						ignoreCase: true,
+						want:       "\"ASCENDING\"i",
+					},
+					&litMatcher{
+						pos:        position{line: 146, col: 57, offset: 5869},
+						val:        "descending",
+						ignoreCase: true,
+						want:       "\"DESCENDING\"i",
+					},
+					&litMatcher{
+						pos:        position{line: 146, col: 73, offset: 5885},
+						val:        "indexed",
+						ignoreCase: true,
+						want:       "\"INDEXED\"i",
+					},
+					&litMatcher{
+						pos:        position{line: 146, col: 86, offset: 5898},
+						val:        "redefines",
+						ignoreCase: true,
+						want:       "\"REDEFINES\"i",
+					},
+					&litMatcher{
+						pos:        position{line: 146, col: 101, offset: 5913},
+						val:        "picture",
+						ignoreCase: true,
+						want:       "\"PICTURE\"i",
+					},
+					&litMatcher{
+						pos:        position{line: 146, col: 114, offset: 5926},
+						val:        "pic",
+						ignoreCase: true,
+						want:       "\"PIC\"i",
+					},
+					&litMatcher{
+						pos:        position{line: 146, col: 123, offset: 5935},
+						val:        "usage",
+						ignoreCase: true,
+						want:       "\"USAGE\"i",
+					},
+					&litMatcher{
+						pos:        position{line: 146, col: 134, offset: 5946},
+						val:        "synchronized",
+						ignoreCase: true,
+						want:       "\"SYNCHRONIZED\"i",
+					},
+					&litMatcher{
+						pos:        position{line: 146, col: 152, offset: 5964},
+						val:        "sync",
+						ignoreCase: true,
+						want:       "\"SYNC\"i",
+					},
+					&litMatcher{
+						pos:        position{line: 146, col: 162, offset: 5974},
+						val:        "justified",
+						ignoreCase: true,
+						want:       "\"JUSTIFIED\"i",
+					},
+					&litMatcher{
+						pos:        position{line: 146, col: 177, offset: 5989},
+						val:        "just",
+						ignoreCase: true,
+						want:       "\"JUST\"i",
+					},
+					&litMatcher{
+						pos:        position{line: 146, col: 187, offset: 5999},
+						val:        "blank",
+						ignoreCase: true,
+						want:       "\"BLANK\"i",
+					},
+					&litMatcher{
+						pos:        position{line: 146, col: 198, offset: 6010},
+						val:        "occurs",
+						ignoreCase: true,
+						want:       "\"OCCURS\"i",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 146, col: 210, offset: 6022},
+						name: "UsageKeyword",
+					},
+				},
+			},
+		},
+		{
+			name: "DOT",
+			pos:  position{line: 150, col: 1, offset: 6048},
+			expr: &litMatcher{
+				pos:        position{line: 150, col: 8, offset: 6055},
+				val:        ".",
 				ignoreCase: false,
 				want:       "\".\"",
 			},
 		},
 		{
 			name: "Space",
-			pos:  position{line: 91, col: 1, offset: 2951},
+			pos:  position{line: 151, col: 1, offset: 6059},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 91, col: 10, offset: 2960},
+				pos: position{line: 151, col: 10, offset: 6068},
 				expr: &charClassMatcher{
-					pos:             position{line: 91, col: 10, offset: 2960},
+					pos:             position{line: 151, col: 10, offset: 6068},
 					val:             "[ \\t]",
 					chars:           []rune{' ', '\t'},
 					basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
@@ -717,9 +1859,9 @@ var g = &grammar{
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 92, col: 1, offset: 2967},
+			pos:  position{line: 152, col: 1, offset: 6075},
 			expr: &charClassMatcher{
-				pos:             position{line: 92, col: 8, offset: 2974},
+				pos:             position{line: 152, col: 8, offset: 6082},
 				val:             "[\\n\\r]",
 				chars:           []rune{'\n', '\r'},
 				basicLatinChars: [128]bool{false, false, false, false, false, false, false, false, false, false, true, false, false, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false},
@@ -729,31 +1871,31 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 93, col: 1, offset: 2981},
+			pos:  position{line: 153, col: 1, offset: 6089},
 			expr: &notExpr{
-				pos: position{line: 93, col: 8, offset: 2988},
+				pos: position{line: 153, col: 8, offset: 6096},
 				expr: &anyMatcher{
-					line: 93, col: 9, offset: 2989,
+					line: 153, col: 9, offset: 6097,
 				},
 			},
 		},
 		{
 			name: "RestOfLine",
-			pos:  position{line: 94, col: 1, offset: 2991},
+			pos:  position{line: 154, col: 1, offset: 6099},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 94, col: 15, offset: 3005},
+				pos: position{line: 154, col: 15, offset: 6113},
 				expr: &seqExpr{
-					pos: position{line: 94, col: 16, offset: 3006},
+					pos: position{line: 154, col: 16, offset: 6114},
 					exprs: []any{
 						&notExpr{
-							pos: position{line: 94, col: 16, offset: 3006},
+							pos: position{line: 154, col: 16, offset: 6114},
 							expr: &ruleRefExpr{
-								pos:  position{line: 94, col: 17, offset: 3007},
+								pos:  position{line: 154, col: 17, offset: 6115},
 								name: "EOL",
 							},
 						},
 						&anyMatcher{
-							line: 94, col: 21, offset: 3011,
+							line: 154, col: 21, offset: 6119,
 						},
 					},
 				},
@@ -761,18 +1903,18 @@ var g = &grammar{
 		},
 		{
 			name: "SpacesOrEOLs",
-			pos:  position{line: 95, col: 1, offset: 3015},
+			pos:  position{line: 155, col: 1, offset: 6123},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 95, col: 17, offset: 3031},
+				pos: position{line: 155, col: 17, offset: 6139},
 				expr: &choiceExpr{
-					pos: position{line: 95, col: 18, offset: 3032},
+					pos: position{line: 155, col: 18, offset: 6140},
 					alternatives: []any{
 						&ruleRefExpr{
-							pos:  position{line: 95, col: 18, offset: 3032},
+							pos:  position{line: 155, col: 18, offset: 6140},
 							name: "Space",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 95, col: 26, offset: 3040},
+							pos:  position{line: 155, col: 26, offset: 6148},
 							name: "EOL",
 						},
 					},
@@ -826,24 +1968,44 @@ func (p *parser) callonUnknownLine1() (any, error) {
 	return p.cur.onUnknownLine1()
 }
 
-func (c *current) onRecord9(cl any) (any, error) {
+func (c *current) onRecord11(cl any) (any, error) {
 	return cl, nil
 }
 
-func (p *parser) callonRecord9() (any, error) {
+func (p *parser) callonRecord11() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRecord11(stack["cl"])
+}
+
+func (c *current) onRecord21(line, level, identifier, clauses, comment any) error {
+	return createAndAddRecordToAST(c.state[astBuilderKey], line, level, identifier, clauses, comment)
+}
+
+func (p *parser) callonRecord21() error {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRecord21(stack["line"], stack["level"], stack["identifier"], stack["clauses"], stack["comment"])
+}
+
+func (c *current) onRecordLine1() (any, error) {
+	return c.pos.line, nil
+}
+
+func (p *parser) callonRecordLine1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onRecord9(stack["cl"])
+	return p.cur.onRecordLine1()
 }
 
-func (c *current) onRecord16(level, identifier, clauses any) error {
-	return createAndAddRecordToAST(c.state[astBuilderKey], level, identifier, clauses)
+func (c *current) onInlineComment1() (any, error) {
+	return getInlineCommentDetails(c.text)
 }
 
-func (p *parser) callonRecord16() error {
+func (p *parser) callonInlineComment1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onRecord16(stack["level"], stack["identifier"], stack["clauses"])
+	return p.cur.onInlineComment1()
 }
 
 func (c *current) onLevel1() (any, error) {
@@ -896,14 +2058,154 @@ func (p *parser) callonPicString1() (any, error) {
 	return p.cur.onPicString1()
 }
 
-func (c *current) onOccursClause1(count any) (any, error) {
-	return getOccursClauseDetails(count)
+func (c *current) onUsageClause1(usage any) (any, error) {
+	return getUsageClauseDetails(usage)
+}
+
+func (p *parser) callonUsageClause1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUsageClause1(stack["usage"])
+}
+
+func (c *current) onUsageKeyword1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonUsageKeyword1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUsageKeyword1()
+}
+
+func (c *current) onSynchronizedClause1() (any, error) {
+	return getSynchronizedClauseDetails()
+}
+
+func (p *parser) callonSynchronizedClause1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSynchronizedClause1()
+}
+
+func (c *current) onJustifiedClause1() (any, error) {
+	return getJustifiedClauseDetails()
+}
+
+func (p *parser) callonJustifiedClause1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJustifiedClause1()
+}
+
+func (c *current) onBlankWhenZeroClause1() (any, error) {
+	return getBlankWhenZeroClauseDetails()
+}
+
+func (p *parser) callonBlankWhenZeroClause1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBlankWhenZeroClause1()
+}
+
+func (c *current) onValueClause17(r any) (any, error) {
+	return r, nil
+}
+
+func (p *parser) callonValueClause17() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onValueClause17(stack["r"])
+}
+
+func (c *current) onValueClause1(first, rest any) (any, error) {
+	return getValueClauseDetails(first, rest)
+}
+
+func (p *parser) callonValueClause1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onValueClause1(stack["first"], stack["rest"])
+}
+
+func (c *current) onValueRange7(l any) (any, error) {
+	return l, nil
+}
+
+func (p *parser) callonValueRange7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onValueRange7(stack["l"])
+}
+
+func (c *current) onValueRange1(from, through any) (any, error) {
+	return getValueRangeDetails(from, through)
+}
+
+func (p *parser) callonValueRange1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onValueRange1(stack["from"], stack["through"])
+}
+
+func (c *current) onAlphanumericLiteral1() (any, error) {
+	return getAlphanumericLiteralDetails(c.text)
+}
+
+func (p *parser) callonAlphanumericLiteral1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAlphanumericLiteral1()
+}
+
+func (c *current) onFigurativeConstant1() (any, error) {
+	return getFigurativeConstantDetails(c.text)
+}
+
+func (p *parser) callonFigurativeConstant1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFigurativeConstant1()
+}
+
+func (c *current) onNumericLiteral1() (any, error) {
+	return getNumericLiteralDetails(c.text)
+}
+
+func (p *parser) callonNumericLiteral1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNumericLiteral1()
+}
+
+func (c *current) onOccursClause13(key any) (any, error) {
+	return key, nil
+}
+
+func (p *parser) callonOccursClause13() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOccursClause13(stack["key"])
+}
+
+func (c *current) onOccursClause20(index any) (any, error) {
+	return index, nil
+}
+
+func (p *parser) callonOccursClause20() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOccursClause20(stack["index"])
+}
+
+func (c *current) onOccursClause1(count, keys, indexes any) (any, error) {
+	return getOccursClauseDetails(count, keys, indexes)
 }
 
 func (p *parser) callonOccursClause1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOccursClause1(stack["count"])
+	return p.cur.onOccursClause1(stack["count"], stack["keys"], stack["indexes"])
 }
 
 func (c *current) onCount1() (any, error) {
@@ -916,6 +2218,56 @@ func (p *parser) callonCount1() (any, error) {
 	return p.cur.onCount1()
 }
 
+func (c *current) onKeyPhrase1(order, names any) (any, error) {
+	return getKeyPhraseDetails(order, names)
+}
+
+func (p *parser) callonKeyPhrase1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onKeyPhrase1(stack["order"], stack["names"])
+}
+
+func (c *current) onIndexedBy1(names any) (any, error) {
+	return names, nil
+}
+
+func (p *parser) callonIndexedBy1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIndexedBy1(stack["names"])
+}
+
+func (c *current) onNameList7(name any) (any, error) {
+	return name, nil
+}
+
+func (p *parser) callonNameList7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNameList7(stack["name"])
+}
+
+func (c *current) onNameList1(first, rest any) (any, error) {
+	return getNameListDetails(first, rest)
+}
+
+func (p *parser) callonNameList1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNameList1(stack["first"], stack["rest"])
+}
+
+func (c *current) onListedName1(identifier any) (any, error) {
+	return identifier, nil
+}
+
+func (p *parser) callonListedName1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onListedName1(stack["identifier"])
+}
+
 var (
 	// errNoRule is returned when the grammar to parse has no rule.
 	errNoRule = errors.New("grammar has no rule")
@@ -930,7 +2282,7 @@ var (
 
 	// errMaxExprCnt is used to signal that the maximum number of
 	// expressions have been parsed.
-	errMaxExprCnt = errors.New("max number of expresssions parsed")
+	errMaxExprCnt = errors.New("max number of expressions parsed")
 )
 
 // Option is a function that can set an option on the parser. It returns
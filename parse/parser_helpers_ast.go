@@ -21,6 +21,8 @@ import (
 //     - Pop records from the working parents stack until the parent is at the top
 //     - Append the current Record to its parent (the Record at the top of the working parents stack)
 //     - If the Record has Children (non-leaf node), append it to working parents stack
+//  3. For Level 88 records, which are condition names rather than data:
+//     - Add a Condition to the last Record that was added
 //
 // This process repeats until all records are processed, after which the ast slice will hold the parsed AST.
 //
@@ -47,11 +49,16 @@ import (
 type astBuilder struct {
 	ast                 []*Record
 	workingParentsStack workingParentsStack
+	// lastRecord is the last Record that was added, which the condition names that follow it belong to.
+	lastRecord *Record
 }
 
+// conditionLevel is the level of condition names.
+const conditionLevel = 88
+
 type workingParentsStack []*Record
 
-func createAndAddRecordToAST(ast, level, identifier, clauses any) error {
+func createAndAddRecordToAST(ast, line, level, identifier, clauses, comment any) error {
 	treeBuilder, ok := ast.(*astBuilder)
 	if !ok {
 		return fmt.Errorf("ast is not a *astBuilder: %v", ast)
@@ -61,6 +68,19 @@ func createAndAddRecordToAST(ast, level, identifier, clauses any) error {
 	if err != nil {
 		return fmt.Errorf("failed to create Record: %w", err)
 	}
+	lineInt, ok := line.(int)
+	if !ok {
+		return fmt.Errorf("line is not an int: %v", line)
+	}
+	newRecord.Line = lineInt
+	// The inline comment is optional.
+	if comment != nil {
+		commentString, ok := comment.(string)
+		if !ok {
+			return fmt.Errorf("comment is not a string: %v", comment)
+		}
+		newRecord.Comment = commentString
+	}
 
 	if err := treeBuilder.addRecord(&newRecord); err != nil {
 		return fmt.Errorf("failed to add Record to AST: %w", err)
@@ -74,6 +94,11 @@ func (ab *astBuilder) addRecord(rec *Record) error {
 		return fmt.Errorf("Record Level cannot be less than 1: %v", rec.Level)
 	}
 
+	if rec.Level == conditionLevel {
+		return ab.addCondition(rec)
+	}
+	ab.lastRecord = rec
+
 	// If a Level 1 Record, append to ast and reset working parents stack to this Record.
 	if rec.Level == 1 {
 		ab.ast = append(ab.ast, rec)
@@ -91,6 +116,9 @@ func (ab *astBuilder) addRecord(rec *Record) error {
 
 	// Append Record to its parent.
 	parent := ab.workingParentsStack.peek()
+	if err := inheritUsage(parent, rec); err != nil {
+		return err
+	}
 	parent.Children = append(parent.Children, rec)
 
 	// If the Record is not a leaf node, append to the working parents stack.
@@ -101,11 +129,45 @@ func (ab *astBuilder) addRecord(rec *Record) error {
 	return nil
 }
 
+// addCondition adds a Level 88 Record as a Condition of the last Record that was added.
+func (ab *astBuilder) addCondition(rec *Record) error {
+	if ab.lastRecord == nil {
+		return fmt.Errorf("condition %s must follow the Record it belongs to", rec.Identifier)
+	}
+	if len(rec.Values) == 0 {
+		return fmt.Errorf("condition %s must have a VALUE clause", rec.Identifier)
+	}
+
+	ab.lastRecord.Conditions = append(ab.lastRecord.Conditions, Condition{Identifier: rec.Identifier, Values: rec.Values})
+	return nil
+}
+
 func isLeafNode(rec *Record) bool {
-	// A Record with a Picture clause is a leaf node and will not have Children.
+	// A Record with a Picture clause is a leaf node and will not have Children. A Record with a usage
+	// that has no Picture clause, such as POINTER, can be either a leaf node or a group.
 	return rec.Pic != Picture{}
 }
 
+// inheritUsage applies the usage of a group to a Record within it, as the usage of a group applies to
+// every elementary Record within it. The Record can only repeat the usage of the group, and a numeric
+// usage such as COMP-3 can only apply to a numeric PIC clause.
+func inheritUsage(parent, rec *Record) error {
+	if !parent.UsageExplicit {
+		return nil
+	}
+	if rec.UsageExplicit && rec.Usage != parent.Usage {
+		return fmt.Errorf("usage %v of Record %s conflicts with usage %v of group %s",
+			rec.Usage, rec.Identifier, parent.Usage, parent.Identifier)
+	}
+	if parent.Usage.IsNumeric() && rec.Pic != (Picture{}) && (!rec.Pic.PicType.IsNumeric() || rec.Pic.Edited) {
+		return fmt.Errorf("usage %v of group %s cannot apply to %v PIC clause %s of Record %s",
+			parent.Usage, parent.Identifier, rec.Pic.PicType, rec.Pic.PicString, rec.Identifier)
+	}
+
+	rec.Usage, rec.UsageExplicit = parent.Usage, true
+	return nil
+}
+
 func getAST(ast any) ([]*Record, error) {
 	treeBuilder, ok := ast.(*astBuilder)
 	if !ok {
@@ -14,10 +14,10 @@ func Test_createAndAddRecordToAST(t *testing.T) {
 		t.Run("Level01Record_AddedToASTAndWorkingParentsStack", func(t *testing.T) {
 			builder := &astBuilder{}
 
-			err := createAndAddRecordToAST(builder, 1, "LEVEL01-RECORD", []any{})
+			err := createAndAddRecordToAST(builder, 2, 1, "LEVEL01-RECORD", []any{}, nil)
 
 			require.NoError(t, err)
-			expectedRecord := &Record{Level: 1, Identifier: "LEVEL01-RECORD"}
+			expectedRecord := &Record{Line: 2, Level: 1, Identifier: "LEVEL01-RECORD"}
 			require.Len(t, builder.ast, 1)
 			require.Len(t, builder.workingParentsStack, 1)
 			xassert.EqualAll(t, expectedRecord, []*Record{builder.ast[0], builder.workingParentsStack[0]})
@@ -27,12 +27,12 @@ func Test_createAndAddRecordToAST(t *testing.T) {
 			rootRecord := &Record{Level: 1, Identifier: "LEVEL01-RECORD"}
 			builder := &astBuilder{ast: []*Record{rootRecord}, workingParentsStack: []*Record{rootRecord}}
 
-			err := createAndAddRecordToAST(builder, 5, "LEVEL05-RECORD", []any{})
+			err := createAndAddRecordToAST(builder, 2, 5, "LEVEL05-RECORD", []any{}, nil)
 
 			require.NoError(t, err)
 			require.Len(t, builder.workingParentsStack, 2)
 			require.Len(t, builder.ast[0].Children, 1)
-			expectedRecord := &Record{Level: 5, Identifier: "LEVEL05-RECORD"}
+			expectedRecord := &Record{Line: 2, Level: 5, Identifier: "LEVEL05-RECORD"}
 			xassert.EqualAll(t, expectedRecord, []*Record{builder.ast[0].Children[0], builder.workingParentsStack[1]})
 
 			assert.Len(t, builder.ast, 1) // assert it is not added to ast
@@ -51,12 +51,12 @@ func Test_createAndAddRecordToAST(t *testing.T) {
 			}
 			builder := &astBuilder{ast: []*Record{rootRecord}, workingParentsStack: []*Record{rootRecord, rootRecord.Children[0]}}
 
-			err := createAndAddRecordToAST(builder, 1, "LEVEL01-RECORD-2", []any{})
+			err := createAndAddRecordToAST(builder, 2, 1, "LEVEL01-RECORD-2", []any{}, nil)
 
 			require.NoError(t, err)
 			require.Len(t, builder.ast, 2)
 			require.Len(t, builder.workingParentsStack, 1)
-			expectedRecord := &Record{Level: 1, Identifier: "LEVEL01-RECORD-2"}
+			expectedRecord := &Record{Line: 2, Level: 1, Identifier: "LEVEL01-RECORD-2"}
 			xassert.EqualAll(t, expectedRecord, []*Record{builder.ast[1], builder.workingParentsStack[0]})
 		})
 
@@ -64,11 +64,11 @@ func Test_createAndAddRecordToAST(t *testing.T) {
 			rootRecord := &Record{Level: 1, Identifier: "LEVEL01-RECORD"}
 			builder := &astBuilder{ast: []*Record{rootRecord}, workingParentsStack: []*Record{rootRecord}}
 
-			err := createAndAddRecordToAST(builder, 5, "LEVEL05-RECORD", []any{Picture{PicType: Alpha, PicCount: 1}}) // a Record with a Picture clause is a leaf node
+			err := createAndAddRecordToAST(builder, 2, 5, "LEVEL05-RECORD", []any{Picture{PicType: Alpha, PicCount: 1}}, nil) // a Record with a Picture clause is a leaf node
 
 			require.NoError(t, err)
 			require.Len(t, builder.ast[0].Children, 1)
-			expectedRecord := &Record{Level: 5, Identifier: "LEVEL05-RECORD", Pic: Picture{PicType: Alpha, PicCount: 1}}
+			expectedRecord := &Record{Line: 2, Level: 5, Identifier: "LEVEL05-RECORD", Pic: Picture{PicType: Alpha, PicCount: 1}}
 			assert.Equal(t, expectedRecord, builder.ast[0].Children[0])
 
 			assert.Len(t, builder.workingParentsStack, 1) // assert it is not added to the working parent stack
@@ -100,12 +100,12 @@ func Test_createAndAddRecordToAST(t *testing.T) {
 			}
 			builder := &astBuilder{ast: []*Record{rootRecord}, workingParentsStack: []*Record{rootRecord, rootRecord.Children[0], rootRecord.Children[0].Children[0]}}
 
-			err := createAndAddRecordToAST(builder, 10, "LEVEL10-RECORD-2", []any{Picture{PicType: Alpha, PicCount: 1}})
+			err := createAndAddRecordToAST(builder, 2, 10, "LEVEL10-RECORD-2", []any{Picture{PicType: Alpha, PicCount: 1}}, nil)
 
 			require.NoError(t, err)
 			groupRecord := rootRecord.Children[0]
 			require.Len(t, groupRecord.Children, 2) // Should be added to end of LEVEL05-RECORD's Children
-			expectedRecord := &Record{Level: 10, Identifier: "LEVEL10-RECORD-2", Pic: Picture{PicType: Alpha, PicCount: 1}}
+			expectedRecord := &Record{Line: 2, Level: 10, Identifier: "LEVEL10-RECORD-2", Pic: Picture{PicType: Alpha, PicCount: 1}}
 			assert.Equal(t, groupRecord.Children[1], expectedRecord)
 		})
 
@@ -128,31 +128,41 @@ func Test_createAndAddRecordToAST(t *testing.T) {
 			}
 			builder := &astBuilder{ast: []*Record{rootRecord}, workingParentsStack: []*Record{rootRecord, rootRecord.Children[0], rootRecord.Children[0].Children[0]}}
 
-			err := createAndAddRecordToAST(builder, 15, "LEVEL15-RECORD", []any{Picture{PicType: Alpha, PicCount: 1}})
+			err := createAndAddRecordToAST(builder, 2, 15, "LEVEL15-RECORD", []any{Picture{PicType: Alpha, PicCount: 1}}, nil)
 
 			require.NoError(t, err)
 			subGroupRecord := rootRecord.Children[0].Children[0]
 			require.Len(t, subGroupRecord.Children, 1) // Should be added LEVEL10-RECORD's Children
-			expectedRecord := &Record{Level: 15, Identifier: "LEVEL15-RECORD", Pic: Picture{PicType: Alpha, PicCount: 1}}
+			expectedRecord := &Record{Line: 2, Level: 15, Identifier: "LEVEL15-RECORD", Pic: Picture{PicType: Alpha, PicCount: 1}}
 			assert.Equal(t, subGroupRecord.Children[0], expectedRecord)
 		})
 	})
 
 	t.Run("Fail", func(t *testing.T) {
 		t.Run("InvalidASTBuilder", func(t *testing.T) {
-			err := createAndAddRecordToAST("not an astBuilder", 1, "LEVEL01-RECORD", []any{})
+			err := createAndAddRecordToAST("not an astBuilder", 2, 1, "LEVEL01-RECORD", []any{}, nil)
 			assert.Error(t, err)
 		})
 
 		t.Run("NotRootRecordAddedToEmptyStack", func(t *testing.T) {
-			err := createAndAddRecordToAST(&astBuilder{}, 5, "LEVEL05-RECORD", []any{})
+			err := createAndAddRecordToAST(&astBuilder{}, 2, 5, "LEVEL05-RECORD", []any{}, nil)
 			assert.Error(t, err)
 		})
 
 		t.Run("InvalidRecordLevel", func(t *testing.T) {
-			err := createAndAddRecordToAST(&astBuilder{}, -1, "INVALID-RECORD", []any{})
+			err := createAndAddRecordToAST(&astBuilder{}, 2, -1, "INVALID-RECORD", []any{}, nil)
 			assert.Error(t, err)
 		})
+
+		t.Run("UsageConflictsWithGroup", func(t *testing.T) {
+			rootRecord := &Record{Level: 1, Identifier: "LEVEL01-RECORD", Usage: PackedDecimal, UsageExplicit: true}
+			builder := &astBuilder{ast: []*Record{rootRecord}, workingParentsStack: []*Record{rootRecord}}
+
+			err := createAndAddRecordToAST(builder, 2, 5, "LEVEL05-RECORD", []any{Picture{PicType: Signed, PicCount: 4}, Binary}, nil)
+
+			assert.Error(t, err)
+			assert.Empty(t, rootRecord.Children)
+		})
 	})
 }
 
@@ -162,12 +172,85 @@ func Test_isLeafNode(t *testing.T) {
 		assert.True(t, isLeafNode(leafNodeRecord))
 	})
 
+	t.Run("UsageRecordWithoutPicture_ReturnsFalse", func(t *testing.T) {
+		usageRecord := &Record{Usage: Pointer}
+		assert.False(t, isLeafNode(usageRecord))
+	})
+
 	t.Run("NonLeafNodeRecord_ReturnsFalse", func(t *testing.T) {
 		nonLeafNodeRecord := &Record{}
 		assert.False(t, isLeafNode(nonLeafNodeRecord))
 	})
 }
 
+func Test_inheritUsage(t *testing.T) {
+	tests := map[string]struct {
+		parent   *Record
+		rec      *Record
+		expected Usage
+		wantErr  bool
+	}{
+		"Success_DisplayGroup": {
+			parent:   &Record{Identifier: "GROUP"},
+			rec:      &Record{Identifier: "RECORD", Usage: Binary, UsageExplicit: true},
+			expected: Binary,
+		},
+		"Success_InheritedFromGroup": {
+			parent:   &Record{Identifier: "GROUP", Usage: PackedDecimal, UsageExplicit: true},
+			rec:      &Record{Identifier: "RECORD", Pic: Picture{PicString: "S9(03)", PicType: Signed}},
+			expected: PackedDecimal,
+		},
+		"Success_RepeatsGroupUsage": {
+			parent:   &Record{Identifier: "GROUP", Usage: Pointer, UsageExplicit: true},
+			rec:      &Record{Identifier: "RECORD", Usage: Pointer, UsageExplicit: true},
+			expected: Pointer,
+		},
+		"Success_InheritedByNestedGroup": {
+			parent:   &Record{Identifier: "GROUP", Usage: PackedDecimal, UsageExplicit: true},
+			rec:      &Record{Identifier: "NESTED-GROUP"},
+			expected: PackedDecimal,
+		},
+		"Fail_ConflictsWithGroupUsage": {
+			parent:   &Record{Identifier: "GROUP", Usage: PackedDecimal, UsageExplicit: true},
+			rec:      &Record{Identifier: "RECORD", Usage: Binary, UsageExplicit: true},
+			expected: Binary,
+			wantErr:  true,
+		},
+		"Fail_ExplicitDisplayConflictsWithGroupUsage": {
+			parent:   &Record{Identifier: "GROUP", Usage: PackedDecimal, UsageExplicit: true},
+			rec:      &Record{Identifier: "RECORD", Usage: Display, UsageExplicit: true},
+			expected: Display,
+			wantErr:  true,
+		},
+		"Fail_ConflictsWithExplicitDisplayGroup": {
+			parent:   &Record{Identifier: "GROUP", Usage: Display, UsageExplicit: true},
+			rec:      &Record{Identifier: "RECORD", Usage: Binary, UsageExplicit: true},
+			expected: Binary,
+			wantErr:  true,
+		},
+		"Fail_NumericUsageOfAlphanumericPic": {
+			parent:   &Record{Identifier: "GROUP", Usage: PackedDecimal, UsageExplicit: true},
+			rec:      &Record{Identifier: "RECORD", Pic: Picture{PicString: "X(03)", PicType: Alpha}},
+			expected: Display,
+			wantErr:  true,
+		},
+		"Fail_NumericUsageOfEditedPic": {
+			parent:   &Record{Identifier: "GROUP", Usage: Binary, UsageExplicit: true},
+			rec:      &Record{Identifier: "RECORD", Pic: Picture{PicString: "ZZ9", PicType: NumericEdited, Edited: true}},
+			expected: Display,
+			wantErr:  true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := inheritUsage(tt.parent, tt.rec)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.expected, tt.rec.Usage)
+		})
+	}
+}
+
 func Test_getAST(t *testing.T) {
 	t.Run("Success_ReturnsAST", func(t *testing.T) {
 		rootRecord1 := &Record{Level: 1, Identifier: "LEVEL01-RECORD-1"}
@@ -4,25 +4,133 @@ package parse
 import (
 	"fmt"
 	"strconv"
+	"strings"
 )
 
 //go:generate pigeon -o parser.generated.go -optimize-parser -optimize-basic-latin copybook.peg
 
 // Record defines a single record in a COBOL copybook.
 type Record struct {
-	Level       int
-	Identifier  string
-	Redefines   string
-	Pic         Picture
-	OccursCount int
-	Children    []*Record
+	// Line is the line of the copybook that the record starts on, counting from 1.
+	Line       int
+	Level      int
+	Identifier string
+	Redefines  string
+	Pic        Picture
+	Usage      Usage
+	// UsageExplicit is true if Usage is declared by a USAGE clause of the record or of a group it is in,
+	// rather than being the default usage. It tells an explicit USAGE DISPLAY apart from no usage.
+	UsageExplicit bool
+	OccursCount   int
+	// Synchronized records are aligned on the natural boundary of their usage.
+	Synchronized bool
+	// Justified records are aligned to the right of their field rather than the left, and are
+	// truncated on the left.
+	Justified bool
+	// BlankWhenZero records are filled with spaces when their value is zero.
+	BlankWhenZero bool
+	// Keys are the keys that the occurrences of an OCCURS table are ordered by, from the most
+	// significant, and Indexes are the names of the indexes of the table.
+	Keys    []Key
+	Indexes []string
+	// Values are the values of the VALUE clause of the record, and Conditions are the condition names
+	// declared for it at level 88.
+	Values     []ValueRange
+	Conditions []Condition
+	// Comment is the floating comment after the period of the record, e.g. @date(YYYYMMDD) for
+	// *> @date(YYYYMMDD).
+	Comment  string
+	Children []*Record
 }
 
+// Condition is a condition name declared at level 88, which is true when its record holds one of its
+// values.
+type Condition struct {
+	Identifier string
+	Values     []ValueRange
+}
+
+// ValueRange is a value of a VALUE clause, or a range of values declared by a THROUGH phrase.
+type ValueRange struct {
+	From Literal
+	// Through is the last value of a range, or nil for a single value.
+	Through *Literal
+}
+
+// LiteralKind defines the kinds of literals of a VALUE clause.
+type LiteralKind int
+
+const (
+	// NumericLiteral is a number with an optional sign and decimal point (e.g. -12.5).
+	NumericLiteral LiteralKind = iota
+	// AlphanumericLiteral is a string between quotes or apostrophes (e.g. "ABC").
+	AlphanumericLiteral
+	// FigurativeConstant is a named constant (e.g. ZERO or SPACES).
+	FigurativeConstant
+)
+
+// Literal is a literal of a VALUE clause.
+type Literal struct {
+	Kind LiteralKind
+	// Text is the number of a numeric literal, the string of an alphanumeric literal without its
+	// delimiters, or the singular name of a figurative constant in upper case, e.g. ZERO for ZEROES.
+	Text string
+}
+
+// Key is a key of an OCCURS table, declared by an ASCENDING or DESCENDING KEY phrase.
+type Key struct {
+	Identifier string
+	Descending bool
+}
+
+// synchronizedClause is the clause value of a SYNCHRONIZED clause. LEFT and RIGHT are treated the same
+// way, as they are on IBM mainframes.
+type synchronizedClause struct{}
+
+// occursClause is the clause value of an OCCURS clause.
+type occursClause struct {
+	count   int
+	keys    []Key
+	indexes []string
+}
+
+// valueClause is the clause value of a VALUE clause.
+type valueClause []ValueRange
+
+// justifiedClause is the clause value of a JUSTIFIED clause.
+type justifiedClause struct{}
+
+// blankWhenZeroClause is the clause value of a BLANK WHEN ZERO clause.
+type blankWhenZeroClause struct{}
+
 // Picture defines the PIC clause details for a record.
 type Picture struct {
 	PicString string
 	PicType   PicType
 	PicCount  int
+	// IntegerDigits and FractionDigits are the number of digit positions before and after the
+	// assumed decimal point.
+	IntegerDigits  int
+	FractionDigits int
+	// ScaleFactor is the power of ten the digits are scaled by through "P" positions.
+	ScaleFactor int
+	Signed      bool
+	Edited      bool
+	// EditMask is the PIC definition of an edited value with its repetition factors expanded, so
+	// that every character of the mask corresponds to a position of the value, e.g. $$,$$9.99CR.
+	EditMask string
+}
+
+// Scale returns the number of digits after the decimal point of the value, including
+// leading scaling positions.
+func (p Picture) Scale() int {
+	return max(0, p.FractionDigits-p.ScaleFactor)
+}
+
+// Precision returns the total number of significant digits of the value, including
+// scaling positions.
+func (p Picture) Precision() int {
+	return max(p.IntegerDigits+p.FractionDigits+max(0, p.ScaleFactor), p.Scale())
 }
 
 func createRecord(level, identifier, clauses any) (Record, error) {
@@ -69,11 +177,38 @@ func (r *Record) processClause(clause any) error {
 			return fmt.Errorf("picture clause already set: %v", r.Pic)
 		}
 		r.Pic = typedClause
-	case int:
+	case Usage:
+		if r.UsageExplicit {
+			return fmt.Errorf("usage clause already set: %v", r.Usage)
+		}
+		r.Usage, r.UsageExplicit = typedClause, true
+	case synchronizedClause:
+		if r.Synchronized {
+			return fmt.Errorf("synchronized clause already set")
+		}
+		r.Synchronized = true
+	case justifiedClause:
+		if r.Justified {
+			return fmt.Errorf("justified clause already set")
+		}
+		r.Justified = true
+	case blankWhenZeroClause:
+		if r.BlankWhenZero {
+			return fmt.Errorf("blank when zero clause already set")
+		}
+		r.BlankWhenZero = true
+	case valueClause:
+		if r.Values != nil {
+			return fmt.Errorf("value clause already set: %v", r.Values)
+		}
+		r.Values = typedClause
+	case occursClause:
 		if r.OccursCount != 0 {
 			return fmt.Errorf("occurs clause already set: %v", r.OccursCount)
 		}
-		r.OccursCount = typedClause
+		r.OccursCount = typedClause.count
+		r.Keys = typedClause.keys
+		r.Indexes = typedClause.indexes
 	default:
 		return fmt.Errorf("unexpected clause type: %T", clause)
 	}
@@ -95,20 +230,185 @@ func getPictureClauseDetails(pic any) (Picture, error) {
 		return Picture{}, fmt.Errorf("pic is not a string: %v", pic)
 	}
 
-	return Picture{
+	// PIC symbols are case-insensitive, so the PIC string is analysed in upper case while the
+	// original spelling is kept.
+	symbols := strings.ToUpper(picString)
+	picture := Picture{
 		PicString: picString,
-		PicType:   parsePICType(picString),
-		PicCount:  parsePICCount(picString),
-	}, nil
+		PicType:   parsePICType(symbols),
+		PicCount:  parsePICCount(symbols),
+		Signed:    parsePICSigned(symbols),
+		Edited:    parsePICEdited(symbols),
+	}
+	if picture.PicType == NumericEdited || picture.PicType == AlphaEdited {
+		picture.EditMask = expandPIC(symbols)
+	}
+	if picture.PicType.IsNumeric() {
+		picture.IntegerDigits, picture.FractionDigits, picture.ScaleFactor = parsePICDigits(symbols)
+	}
+
+	return picture, nil
+}
+
+func getSynchronizedClauseDetails() (synchronizedClause, error) {
+	return synchronizedClause{}, nil
+}
+
+func getJustifiedClauseDetails() (justifiedClause, error) {
+	return justifiedClause{}, nil
+}
+
+func getBlankWhenZeroClauseDetails() (blankWhenZeroClause, error) {
+	return blankWhenZeroClause{}, nil
 }
 
-func getOccursClauseDetails(count any) (int, error) {
+func getOccursClauseDetails(count, keys, indexes any) (occursClause, error) {
 	countInt, ok := count.(int)
 	if !ok {
-		return 0, fmt.Errorf("count is not an int: %v", count)
+		return occursClause{}, fmt.Errorf("count is not an int: %v", count)
 	}
 
-	return countInt, nil
+	keyPhrases, ok := keys.([]any)
+	if !ok {
+		return occursClause{}, fmt.Errorf("keys is not a []any: %v", keys)
+	}
+	clause := occursClause{count: countInt}
+	for _, keyPhrase := range keyPhrases {
+		phraseKeys, ok := keyPhrase.([]Key)
+		if !ok {
+			return occursClause{}, fmt.Errorf("key phrase is not a []Key: %v", keyPhrase)
+		}
+		clause.keys = append(clause.keys, phraseKeys...)
+	}
+
+	// The INDEXED BY phrase is optional.
+	if indexes != nil {
+		clause.indexes, ok = indexes.([]string)
+		if !ok {
+			return occursClause{}, fmt.Errorf("indexes is not a []string: %v", indexes)
+		}
+	}
+
+	return clause, nil
+}
+
+func getKeyPhraseDetails(order, names any) ([]Key, error) {
+	orderBytes, ok := order.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("order is not a byte slice: %v", order)
+	}
+
+	nameSlice, ok := names.([]string)
+	if !ok {
+		return nil, fmt.Errorf("names is not a []string: %v", names)
+	}
+
+	descending := strings.EqualFold(string(orderBytes), "DESCENDING")
+	keys := make([]Key, 0, len(nameSlice))
+	for _, name := range nameSlice {
+		keys = append(keys, Key{Identifier: name, Descending: descending})
+	}
+
+	return keys, nil
+}
+
+func getNameListDetails(first, rest any) ([]string, error) {
+	firstName, ok := first.(string)
+	if !ok {
+		return nil, fmt.Errorf("first name is not a string: %v", first)
+	}
+
+	restSlice, ok := rest.([]any)
+	if !ok {
+		return nil, fmt.Errorf("rest is not a []any: %v", rest)
+	}
+
+	names := []string{firstName}
+	for _, name := range restSlice {
+		nameString, ok := name.(string)
+		if !ok {
+			return nil, fmt.Errorf("name is not a string: %v", name)
+		}
+		names = append(names, nameString)
+	}
+
+	return names, nil
+}
+
+func getValueClauseDetails(first, rest any) (valueClause, error) {
+	firstRange, ok := first.(ValueRange)
+	if !ok {
+		return nil, fmt.Errorf("first value is not a ValueRange: %v", first)
+	}
+
+	restSlice, ok := rest.([]any)
+	if !ok {
+		return nil, fmt.Errorf("rest is not a []any: %v", rest)
+	}
+
+	values := valueClause{firstRange}
+	for _, value := range restSlice {
+		valueRange, ok := value.(ValueRange)
+		if !ok {
+			return nil, fmt.Errorf("value is not a ValueRange: %v", value)
+		}
+		values = append(values, valueRange)
+	}
+
+	return values, nil
+}
+
+func getValueRangeDetails(from, through any) (ValueRange, error) {
+	fromLiteral, ok := from.(Literal)
+	if !ok {
+		return ValueRange{}, fmt.Errorf("from is not a Literal: %v", from)
+	}
+
+	// The THROUGH phrase is optional.
+	valueRange := ValueRange{From: fromLiteral}
+	if through != nil {
+		throughLiteral, ok := through.(Literal)
+		if !ok {
+			return ValueRange{}, fmt.Errorf("through is not a Literal: %v", through)
+		}
+		valueRange.Through = &throughLiteral
+	}
+
+	return valueRange, nil
+}
+
+func getAlphanumericLiteralDetails(text []byte) (Literal, error) {
+	delimiter := string(text[:1])
+	content := string(text[1 : len(text)-1])
+	return Literal{Kind: AlphanumericLiteral, Text: strings.ReplaceAll(content, delimiter+delimiter, delimiter)}, nil
+}
+
+// figurativeConstants maps the names of figurative constants to their singular names.
+var figurativeConstants = map[string]string{
+	"ZEROS":       "ZERO",
+	"ZEROES":      "ZERO",
+	"SPACES":      "SPACE",
+	"HIGH-VALUES": "HIGH-VALUE",
+	"LOW-VALUES":  "LOW-VALUE",
+	"QUOTES":      "QUOTE",
+	"NULLS":       "NULL",
+}
+
+func getFigurativeConstantDetails(text []byte) (Literal, error) {
+	name := strings.ToUpper(string(text))
+	if singular, ok := figurativeConstants[name]; ok {
+		name = singular
+	}
+	return Literal{Kind: FigurativeConstant, Text: name}, nil
+}
+
+func getNumericLiteralDetails(text []byte) (Literal, error) {
+	return Literal{Kind: NumericLiteral, Text: string(text)}, nil
+}
+
+func getInlineCommentDetails(text []byte) (string, error) {
+	_, comment, _ := strings.Cut(string(text), "*>")
+	return strings.TrimSpace(comment), nil
 }
 
 func parseIntFromBytes(value any) (int, error) {
@@ -29,7 +29,7 @@ func Test_createRecord(t *testing.T) {
 	td := newTestData()
 
 	t.Run("Success", func(t *testing.T) {
-		result, err := createRecord(td.level, td.identifier, []any{td.redefines, td.pic, td.occursCount})
+		result, err := createRecord(td.level, td.identifier, []any{td.redefines, td.pic, occursClause{count: td.occursCount}})
 		require.NoError(t, err)
 		assert.Equal(t, Record{
 			Level:       td.level,
@@ -40,6 +40,12 @@ func Test_createRecord(t *testing.T) {
 		}, result)
 	})
 
+	t.Run("Fail_DuplicateUsageClause", func(t *testing.T) {
+		result, err := createRecord(td.level, td.identifier, []any{Display, PackedDecimal})
+		assert.Error(t, err)
+		assert.Empty(t, result)
+	})
+
 	t.Run("Fail_IncorrectLevelType", func(t *testing.T) {
 		result, err := createRecord("invalid type", td.identifier, []any{})
 		assert.Error(t, err)
@@ -82,10 +88,40 @@ func Test_processClause(t *testing.T) {
 		},
 		"Success_OccursClause": {
 			record:   Record{},
-			clause:   td.occursCount,
+			clause:   occursClause{count: td.occursCount},
 			expected: Record{OccursCount: td.occursCount},
 			wantErr:  false,
 		},
+		"Success_OccursClauseWithKeysAndIndexes": {
+			record:   Record{},
+			clause:   occursClause{count: td.occursCount, keys: []Key{{Identifier: "KEY-1"}}, indexes: []string{"IDX-1"}},
+			expected: Record{OccursCount: td.occursCount, Keys: []Key{{Identifier: "KEY-1"}}, Indexes: []string{"IDX-1"}},
+			wantErr:  false,
+		},
+		"Success_UsageClause": {
+			record:   Record{},
+			clause:   Pointer,
+			expected: Record{Usage: Pointer, UsageExplicit: true},
+			wantErr:  false,
+		},
+		"Success_SynchronizedClause": {
+			record:   Record{},
+			clause:   synchronizedClause{},
+			expected: Record{Synchronized: true},
+			wantErr:  false,
+		},
+		"Success_JustifiedClause": {
+			record:   Record{},
+			clause:   justifiedClause{},
+			expected: Record{Justified: true},
+			wantErr:  false,
+		},
+		"Success_BlankWhenZeroClause": {
+			record:   Record{},
+			clause:   blankWhenZeroClause{},
+			expected: Record{BlankWhenZero: true},
+			wantErr:  false,
+		},
 		"Fail_RedefinesAlreadySet": {
 			record:   Record{Redefines: td.redefines},
 			clause:   td.redefines,
@@ -100,10 +136,34 @@ func Test_processClause(t *testing.T) {
 		},
 		"Fail_OccursAlreadySet": {
 			record:   Record{OccursCount: td.occursCount},
-			clause:   td.occursCount,
+			clause:   occursClause{count: td.occursCount},
 			expected: Record{OccursCount: td.occursCount},
 			wantErr:  true,
 		},
+		"Fail_UsageAlreadySet": {
+			record:   Record{Usage: Index, UsageExplicit: true},
+			clause:   Pointer,
+			expected: Record{Usage: Index, UsageExplicit: true},
+			wantErr:  true,
+		},
+		"Fail_SynchronizedAlreadySet": {
+			record:   Record{Synchronized: true},
+			clause:   synchronizedClause{},
+			expected: Record{Synchronized: true},
+			wantErr:  true,
+		},
+		"Fail_JustifiedAlreadySet": {
+			record:   Record{Justified: true},
+			clause:   justifiedClause{},
+			expected: Record{Justified: true},
+			wantErr:  true,
+		},
+		"Fail_BlankWhenZeroAlreadySet": {
+			record:   Record{BlankWhenZero: true},
+			clause:   blankWhenZeroClause{},
+			expected: Record{BlankWhenZero: true},
+			wantErr:  true,
+		},
 		"Fail_UnexpectedClauseType": {
 			record:   Record{},
 			clause:   12.5,
@@ -135,10 +195,35 @@ func Test_getClauseDetails(t *testing.T) {
 			require.NoError(t, err)
 			assert.Equal(t, td.pic, result)
 		})
+		t.Run("LowerCasePictureClause", func(t *testing.T) {
+			result, err := getPictureClauseDetails("s9(5)v99")
+			require.NoError(t, err)
+			assert.Equal(t, Picture{PicString: "s9(5)v99", PicType: Decimal, PicCount: 8, IntegerDigits: 5, FractionDigits: 2, Signed: true}, result)
+		})
 		t.Run("OccursClause", func(t *testing.T) {
-			result, err := getOccursClauseDetails(td.occursCount)
+			result, err := getOccursClauseDetails(td.occursCount, []any{}, nil)
+			require.NoError(t, err)
+			assert.Equal(t, occursClause{count: td.occursCount}, result)
+		})
+		t.Run("OccursClauseWithKeysAndIndexes", func(t *testing.T) {
+			keys := []any{[]Key{{Identifier: "KEY-1"}}, []Key{{Identifier: "KEY-2", Descending: true}}}
+			result, err := getOccursClauseDetails(td.occursCount, keys, []string{"IDX-1", "IDX-2"})
+			require.NoError(t, err)
+			assert.Equal(t, occursClause{
+				count:   td.occursCount,
+				keys:    []Key{{Identifier: "KEY-1"}, {Identifier: "KEY-2", Descending: true}},
+				indexes: []string{"IDX-1", "IDX-2"},
+			}, result)
+		})
+		t.Run("KeyPhrase", func(t *testing.T) {
+			result, err := getKeyPhraseDetails([]byte("descending"), []string{"KEY-1", "KEY-2"})
 			require.NoError(t, err)
-			assert.Equal(t, td.occursCount, result)
+			assert.Equal(t, []Key{{Identifier: "KEY-1", Descending: true}, {Identifier: "KEY-2", Descending: true}}, result)
+		})
+		t.Run("NameList", func(t *testing.T) {
+			result, err := getNameListDetails("NAME-1", []any{"NAME-2"})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"NAME-1", "NAME-2"}, result)
 		})
 	})
 
@@ -154,7 +239,17 @@ func Test_getClauseDetails(t *testing.T) {
 			assert.Empty(t, result)
 		})
 		t.Run("OccursClause", func(t *testing.T) {
-			result, err := getOccursClauseDetails("invalid type")
+			result, err := getOccursClauseDetails("invalid type", []any{}, nil)
+			assert.Error(t, err)
+			assert.Empty(t, result)
+		})
+		t.Run("KeyPhrase", func(t *testing.T) {
+			result, err := getKeyPhraseDetails("invalid type", []string{"KEY-1"})
+			assert.Error(t, err)
+			assert.Empty(t, result)
+		})
+		t.Run("NameList", func(t *testing.T) {
+			result, err := getNameListDetails(-1, []any{})
 			assert.Error(t, err)
 			assert.Empty(t, result)
 		})
@@ -174,3 +269,24 @@ func Test_parseIntFromBytes(t *testing.T) {
 		assert.Zero(t, result)
 	})
 }
+
+func TestPicture_PrecisionAndScale(t *testing.T) {
+	tests := map[string]struct {
+		pic               Picture
+		expectedPrecision int
+		expectedScale     int
+	}{
+		"Integer":         {Picture{IntegerDigits: 5}, 5, 0},
+		"ImpliedDecimal":  {Picture{IntegerDigits: 5, FractionDigits: 2}, 7, 2},
+		"LeadingScaling":  {Picture{FractionDigits: 2, ScaleFactor: -3}, 5, 5},
+		"TrailingScaling": {Picture{IntegerDigits: 2, ScaleFactor: 3}, 5, 0},
+		"Alpha":           {Picture{}, 0, 0},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedPrecision, tt.pic.Precision())
+			assert.Equal(t, tt.expectedScale, tt.pic.Scale())
+		})
+	}
+}
@@ -39,38 +39,52 @@ func Test_ParseNormalisedCopybook(t *testing.T) {
 `),
 			expected: []*Record{
 				{
+					Line:       3,
 					Level:      1,
 					Identifier: "RECORD-1",
 					Children: []*Record{
 						{
+							Line:       4,
 							Level:      3,
 							Identifier: "FILLER",
 							Pic:        Picture{PicString: "X(31)", PicType: Alpha, PicCount: 31},
 						},
 						{
+							Line:       5,
 							Level:      3,
 							Identifier: "RECORD-2",
 							Pic:        Picture{PicString: "X(01)", PicType: Alpha, PicCount: 1},
+							Conditions: []Condition{
+								{Identifier: "RECORD-3", Values: []ValueRange{{From: Literal{Kind: AlphanumericLiteral, Text: "S"}}}},
+								{Identifier: "RECORD-4", Values: []ValueRange{{From: Literal{Kind: AlphanumericLiteral, Text: "P"}}}},
+							},
 						},
 						{
+							Line:       9,
 							Level:      3,
 							Identifier: "RECORD-5",
 							Children: []*Record{
 								{
+									Line:        10,
 									Level:       5,
 									Identifier:  "RECORD-6",
 									OccursCount: 10,
+									Indexes:     []string{"X-:XXXX:-DBT"},
 									Children: []*Record{
 										{
-											Level:      7,
-											Identifier: "RECORD-7",
-											Pic:        Picture{PicString: "S9(07)", PicType: Signed, PicCount: 8},
+											Line:          13,
+											Level:         7,
+											Identifier:    "RECORD-7",
+											Pic:           Picture{PicString: "S9(07)", PicType: Signed, PicCount: 8, IntegerDigits: 7, Signed: true},
+											Usage:         PackedDecimal,
+											UsageExplicit: true,
 										},
 									},
 								},
 							},
 						},
 						{
+							Line:       15,
 							Level:      3,
 							Identifier: "FILLER",
 							Pic:        Picture{PicString: "X(190)", PicType: Alpha, PicCount: 190},
@@ -78,15 +92,18 @@ func Test_ParseNormalisedCopybook(t *testing.T) {
 					},
 				},
 				{
+					Line:       16,
 					Level:      1,
 					Identifier: "RECORD-8",
 					Children: []*Record{
 						{
+							Line:       17,
 							Level:      5,
 							Identifier: "RECORD-9",
 							Pic:        Picture{PicString: "X(02)", PicType: Alpha, PicCount: 2},
 						},
 						{
+							Line:       18,
 							Level:      5,
 							Identifier: "RECORD-10",
 							Pic:        Picture{PicString: "X(02)", PicType: Alpha, PicCount: 2},
@@ -97,6 +114,260 @@ func Test_ParseNormalisedCopybook(t *testing.T) {
 			},
 			assertError: assert.NoError,
 		},
+		"GroupUsage_InheritedByChildren": {
+			input: []byte(`       01  RECORD-1.                                                    
+           05  AMOUNTS             USAGE COMP-3.                        
+               10  AMOUNT-1        PIC S9(07)V99.                       
+               10  AMOUNT-2        PIC S9(05)      COMP-3.              
+           05  POINTERS            USAGE POINTER.                       
+               10  POINTER-1.                                           
+`),
+			expected: []*Record{
+				{
+					Line:       1,
+					Level:      1,
+					Identifier: "RECORD-1",
+					Children: []*Record{
+						{
+							Line:          2,
+							Level:         5,
+							Identifier:    "AMOUNTS",
+							Usage:         PackedDecimal,
+							UsageExplicit: true,
+							Children: []*Record{
+								{
+									Line:          3,
+									Level:         10,
+									Identifier:    "AMOUNT-1",
+									Pic:           Picture{PicString: "S9(07)V99", PicType: Decimal, PicCount: 10, IntegerDigits: 7, FractionDigits: 2, Signed: true},
+									Usage:         PackedDecimal,
+									UsageExplicit: true,
+								},
+								{
+									Line:          4,
+									Level:         10,
+									Identifier:    "AMOUNT-2",
+									Pic:           Picture{PicString: "S9(05)", PicType: Signed, PicCount: 6, IntegerDigits: 5, Signed: true},
+									Usage:         PackedDecimal,
+									UsageExplicit: true,
+								},
+							},
+						},
+						{
+							Line:          5,
+							Level:         5,
+							Identifier:    "POINTERS",
+							Usage:         Pointer,
+							UsageExplicit: true,
+							Children: []*Record{
+								{
+									Line:          6,
+									Level:         10,
+									Identifier:    "POINTER-1",
+									Usage:         Pointer,
+									UsageExplicit: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			assertError: assert.NoError,
+		},
+		"InlineComments_ReturnsParsedASTWithComments": {
+			input: []byte(`       01  TXN-REC.                                                     
+           05  TXN-DATE            PIC 9(08). *> @date(YYYYMMDD)        
+           05  TXN-DAY             PIC 9(07). *>Julian day              
+           05  TXN-AMOUNT          PIC 9(05).                           
+`),
+			expected: []*Record{
+				{
+					Line:       1,
+					Level:      1,
+					Identifier: "TXN-REC",
+					Children: []*Record{
+						{
+							Line:       2,
+							Level:      5,
+							Identifier: "TXN-DATE",
+							Pic:        Picture{PicString: "9(08)", PicType: Unsigned, PicCount: 8, IntegerDigits: 8},
+							Comment:    "@date(YYYYMMDD)",
+						},
+						{
+							Line:       3,
+							Level:      5,
+							Identifier: "TXN-DAY",
+							Pic:        Picture{PicString: "9(07)", PicType: Unsigned, PicCount: 7, IntegerDigits: 7},
+							Comment:    "Julian day",
+						},
+						{
+							Line:       4,
+							Level:      5,
+							Identifier: "TXN-AMOUNT",
+							Pic:        Picture{PicString: "9(05)", PicType: Unsigned, PicCount: 5, IntegerDigits: 5},
+						},
+					},
+				},
+			},
+			assertError: assert.NoError,
+		},
+		"ValuesAndConditions_ReturnsParsedASTWithValuesAndConditions": {
+			input: []byte(`       01  ACCOUNT.                                                     
+           05  ACCT-STATUS         PIC X(01)       VALUE SPACE.         
+               88  ACCT-OPEN                       VALUE 'O' "A".       
+               88  ACCT-CLOSED                     VALUES ARE 'C'       
+                                                   'X' THRU 'Z'.        
+           05  ACCT-NAME           PIC X(10)       VALUE 'IT''S'.       
+           05  ACCT-TIER           PIC S9(03)      VALUE -1.            
+               88  ACCT-GOLD                       VALUE 1 THROUGH 10.  
+               88  ACCT-NONE                       VALUE ZEROES.        
+           05  ACCT-RATE           PIC 9V99        VALUE IS .5.         
+`),
+			expected: []*Record{
+				{
+					Line:       1,
+					Level:      1,
+					Identifier: "ACCOUNT",
+					Children: []*Record{
+						{
+							Line:       2,
+							Level:      5,
+							Identifier: "ACCT-STATUS",
+							Pic:        Picture{PicString: "X(01)", PicType: Alpha, PicCount: 1},
+							Values:     []ValueRange{{From: Literal{Kind: FigurativeConstant, Text: "SPACE"}}},
+							Conditions: []Condition{
+								{Identifier: "ACCT-OPEN", Values: []ValueRange{
+									{From: Literal{Kind: AlphanumericLiteral, Text: "O"}},
+									{From: Literal{Kind: AlphanumericLiteral, Text: "A"}},
+								}},
+								{Identifier: "ACCT-CLOSED", Values: []ValueRange{
+									{From: Literal{Kind: AlphanumericLiteral, Text: "C"}},
+									{From: Literal{Kind: AlphanumericLiteral, Text: "X"}, Through: &Literal{Kind: AlphanumericLiteral, Text: "Z"}},
+								}},
+							},
+						},
+						{
+							Line:       6,
+							Level:      5,
+							Identifier: "ACCT-NAME",
+							Pic:        Picture{PicString: "X(10)", PicType: Alpha, PicCount: 10},
+							Values:     []ValueRange{{From: Literal{Kind: AlphanumericLiteral, Text: "IT'S"}}},
+						},
+						{
+							Line:       7,
+							Level:      5,
+							Identifier: "ACCT-TIER",
+							Pic:        Picture{PicString: "S9(03)", PicType: Signed, PicCount: 4, IntegerDigits: 3, Signed: true},
+							Values:     []ValueRange{{From: Literal{Kind: NumericLiteral, Text: "-1"}}},
+							Conditions: []Condition{
+								{Identifier: "ACCT-GOLD", Values: []ValueRange{
+									{From: Literal{Kind: NumericLiteral, Text: "1"}, Through: &Literal{Kind: NumericLiteral, Text: "10"}},
+								}},
+								{Identifier: "ACCT-NONE", Values: []ValueRange{{From: Literal{Kind: FigurativeConstant, Text: "ZERO"}}}},
+							},
+						},
+						{
+							Line:       10,
+							Level:      5,
+							Identifier: "ACCT-RATE",
+							Pic:        Picture{PicString: "9V99", PicType: Decimal, PicCount: 3, IntegerDigits: 1, FractionDigits: 2},
+							Values:     []ValueRange{{From: Literal{Kind: NumericLiteral, Text: ".5"}}},
+						},
+					},
+				},
+			},
+			assertError: assert.NoError,
+		},
+		"ConditionWithoutRecord_ReturnsError": {
+			input: []byte(`       88  ORPHAN                              VALUE 'Y'.               
+       01  ACCOUNT.                                                     
+           05  ACCT-STATUS         PIC X(01).                           
+`),
+			expected:    nil,
+			assertError: assert.Error,
+		},
+		"LowerCaseCopybook_ReturnsParsedAST": {
+			input: []byte(`      * Customer record, mixed case.                                    
+       01  cust-rec.                                                    
+           05  cust-name           pic x(20).                           
+           05  Cust-Id             Pic S9(07)V99   usage is comp-3.     
+           05  cust-codes          occurs 2 times  pic a(2).            
+           05  cust-alt            redefines Cust-Id                    
+                                   picture s9(09)  binary sync.         
+`),
+			expected: []*Record{
+				{
+					Line:       2,
+					Level:      1,
+					Identifier: "cust-rec",
+					Children: []*Record{
+						{
+							Line:       3,
+							Level:      5,
+							Identifier: "cust-name",
+							Pic:        Picture{PicString: "x(20)", PicType: Alpha, PicCount: 20},
+						},
+						{
+							Line:          4,
+							Level:         5,
+							Identifier:    "Cust-Id",
+							Pic:           Picture{PicString: "S9(07)V99", PicType: Decimal, PicCount: 10, IntegerDigits: 7, FractionDigits: 2, Signed: true},
+							Usage:         PackedDecimal,
+							UsageExplicit: true,
+						},
+						{
+							Line:        5,
+							Level:       5,
+							Identifier:  "cust-codes",
+							OccursCount: 2,
+							Pic:         Picture{PicString: "a(2)", PicType: Alpha, PicCount: 2},
+						},
+						{
+							Line:          6,
+							Level:         5,
+							Identifier:    "cust-alt",
+							Redefines:     "Cust-Id",
+							Pic:           Picture{PicString: "s9(09)", PicType: Signed, PicCount: 10, IntegerDigits: 9, Signed: true},
+							Usage:         Binary,
+							UsageExplicit: true,
+							Synchronized:  true,
+						},
+					},
+				},
+			},
+			assertError: assert.NoError,
+		},
+		"InvalidCopybookWithConflictingGroupUsage_ReturnsError": {
+			input: []byte(`       01  RECORD-1                USAGE COMP-3.                        
+           05  RECORD-2            PIC S9(04)      COMP.                
+`),
+			assertError: assert.Error,
+		},
+		"InvalidCopybookWithExplicitDisplayInNumericGroup_ReturnsError": {
+			input: []byte(`       01  RECORD-1                USAGE COMP-3.                        
+           05  RECORD-2            PIC 9(04)       USAGE DISPLAY.       
+`),
+			assertError: assert.Error,
+		},
+		"InvalidCopybookWithAlphanumericFieldInNumericGroup_ReturnsError": {
+			input: []byte(`       01  RECORD-1                USAGE COMP-3.                        
+           05  RECORD-2            PIC S9(04).                          
+           05  RECORD-3            PIC X(03).                           
+`),
+			assertError: assert.Error,
+		},
+		"InvalidCopybookWithDuplicateClause_ReturnsError": {
+			input: []byte(`       01  RECORD-1.                                                    
+           05  RECORD-2            PIC X(01) PIC X(02).                 
+`),
+			assertError: assert.Error,
+		},
+		"InvalidCopybookWithDuplicateUsage_ReturnsError": {
+			input: []byte(`       01  RECORD-1.                                                    
+           05  RECORD-2            DISPLAY PIC S9(04) COMP-3.           
+`),
+			assertError: assert.Error,
+		},
 		"InvalidCopybookWithNo01Record_ReturnsError": {
 			input: []byte(`       05  RECORD-1.                                                    
            10  FILLER              PIC X(31).                           
@@ -126,7 +397,7 @@ func Test_ParseNormalisedCopybook(t *testing.T) {
 func Test_ParseIndividualRecord(t *testing.T) {
 	t.Run("Level01Record", func(t *testing.T) {
 		input := []byte("       01  RECORD-1.                                                    ")
-		expected := []*Record{{Level: 1, Identifier: "RECORD-1"}}
+		expected := []*Record{{Line: 1, Level: 1, Identifier: "RECORD-1"}}
 		got, err := BuildAST(input)
 		require.NoError(t, err)
 		assert.Equal(t, expected, got)
@@ -135,7 +406,7 @@ func Test_ParseIndividualRecord(t *testing.T) {
 	t.Run("Level01RecordWithRedefines", func(t *testing.T) {
 		input := []byte(`       01  RECORD-2                                                     
                                    REDEFINES RECORD-1.                  `)
-		expected := []*Record{{Level: 1, Identifier: "RECORD-2", Redefines: "RECORD-1"}}
+		expected := []*Record{{Line: 1, Level: 1, Identifier: "RECORD-2", Redefines: "RECORD-1"}}
 		got, err := BuildAST(input)
 		require.NoError(t, err)
 		assert.Equal(t, expected, got)
@@ -149,6 +420,7 @@ func Test_ParseIndividualRecord(t *testing.T) {
 			input: []byte("           05  RECORD                             PIC  X(505).          "),
 			expected: []*Record{
 				{
+					Line:       2,
 					Level:      5,
 					Identifier: "RECORD",
 					Pic:        Picture{PicString: "X(505)", PicType: Alpha, PicCount: 505},
@@ -159,9 +431,66 @@ func Test_ParseIndividualRecord(t *testing.T) {
 			input: []byte("           10  RECORD                          PIC  9(03).9(4)-.        "),
 			expected: []*Record{
 				{
+					Line:       2,
 					Level:      10,
 					Identifier: "RECORD",
-					Pic:        Picture{PicString: "9(03).9(4)-", PicType: Decimal, PicCount: 9},
+					Pic: Picture{
+						PicString:      "9(03).9(4)-",
+						PicType:        Decimal,
+						PicCount:       9,
+						IntegerDigits:  3,
+						FractionDigits: 4,
+						Signed:         true,
+						Edited:         true,
+					},
+				},
+			},
+		},
+		"PIC numeric edited": {
+			input: []byte("           05  RECORD                          PIC  $$,$$9.99CR.        "),
+			expected: []*Record{
+				{
+					Line:       2,
+					Level:      5,
+					Identifier: "RECORD",
+					Pic: Picture{
+						PicString:      "$$,$$9.99CR",
+						PicType:        NumericEdited,
+						PicCount:       11,
+						IntegerDigits:  4,
+						FractionDigits: 2,
+						Signed:         true,
+						Edited:         true,
+						EditMask:       "$$,$$9.99CR",
+					},
+				},
+			},
+		},
+		"PIC alpha edited": {
+			input: []byte("           05  RECORD                          PIC  X(3)/X(2).          "),
+			expected: []*Record{
+				{
+					Line:       2,
+					Level:      5,
+					Identifier: "RECORD",
+					Pic: Picture{
+						PicString: "X(3)/X(2)",
+						PicType:   AlphaEdited,
+						PicCount:  6,
+						Edited:    true,
+						EditMask:  "XXX/XX",
+					},
+				},
+			},
+		},
+		"PIC national": {
+			input: []byte("           05  RECORD                          PIC  N(20).              "),
+			expected: []*Record{
+				{
+					Line:       2,
+					Level:      5,
+					Identifier: "RECORD",
+					Pic:        Picture{PicString: "N(20)", PicType: National, PicCount: 40},
 				},
 			},
 		},
@@ -170,9 +499,65 @@ func Test_ParseIndividualRecord(t *testing.T) {
 `),
 			expected: []*Record{
 				{
-					Level:      5,
-					Identifier: "RECORD",
-					Pic:        Picture{PicString: "S9(09)", PicType: Signed, PicCount: 10},
+					Line:          2,
+					Level:         5,
+					Identifier:    "RECORD",
+					Pic:           Picture{PicString: "S9(09)", PicType: Signed, PicCount: 10, IntegerDigits: 9, Signed: true},
+					Usage:         PackedDecimal,
+					UsageExplicit: true,
+				},
+			},
+		},
+		"PIC with USAGE IS COMPUTATIONAL-5": {
+			input: []byte("           05  RECORD          PIC S9(04)  USAGE IS COMPUTATIONAL-5.    "),
+			expected: []*Record{
+				{
+					Line:          2,
+					Level:         5,
+					Identifier:    "RECORD",
+					Pic:           Picture{PicString: "S9(04)", PicType: Signed, PicCount: 5, IntegerDigits: 4, Signed: true},
+					Usage:         NativeBinary,
+					UsageExplicit: true,
+				},
+			},
+		},
+		"COMP-2 without PIC": {
+			input: []byte("           05  RECORD                          COMP-2.                  "),
+			expected: []*Record{
+				{
+					Line:          2,
+					Level:         5,
+					Identifier:    "RECORD",
+					Usage:         Double,
+					UsageExplicit: true,
+				},
+			},
+		},
+		"PIC with BINARY SYNC": {
+			input: []byte("           05  RECORD          PIC 9(08) BINARY SYNC.                   "),
+			expected: []*Record{
+				{
+					Line:          2,
+					Level:         5,
+					Identifier:    "RECORD",
+					Pic:           Picture{PicString: "9(08)", PicType: Unsigned, PicCount: 8, IntegerDigits: 8},
+					Usage:         Binary,
+					UsageExplicit: true,
+					Synchronized:  true,
+				},
+			},
+		},
+		"PIC with SYNCHRONIZED RIGHT": {
+			input: []byte("           05  RECORD          PIC S9(04) COMP SYNCHRONIZED RIGHT.      "),
+			expected: []*Record{
+				{
+					Line:          2,
+					Level:         5,
+					Identifier:    "RECORD",
+					Pic:           Picture{PicString: "S9(04)", PicType: Signed, PicCount: 5, IntegerDigits: 4, Signed: true},
+					Usage:         Binary,
+					UsageExplicit: true,
+					Synchronized:  true,
 				},
 			},
 		},
@@ -182,6 +567,7 @@ func Test_ParseIndividualRecord(t *testing.T) {
 `),
 			expected: []*Record{
 				{
+					Line:       2,
 					Level:      7,
 					Identifier: "RECORD",
 					Pic:        Picture{PicString: "X(10)", PicType: Alpha, PicCount: 10},
@@ -194,9 +580,93 @@ func Test_ParseIndividualRecord(t *testing.T) {
 `),
 			expected: []*Record{
 				{
+					Line:       2,
 					Level:      10,
 					Identifier: "RECORD",
 					Pic:        Picture{PicString: "X(15)", PicType: Alpha, PicCount: 15},
+					Justified:  true,
+				},
+			},
+		},
+		"JUST before PIC": {
+			input: []byte("           05  RECORD          JUST PIC X(05).                          "),
+			expected: []*Record{
+				{
+					Line:       2,
+					Level:      5,
+					Identifier: "RECORD",
+					Pic:        Picture{PicString: "X(05)", PicType: Alpha, PicCount: 5},
+					Justified:  true,
+				},
+			},
+		},
+		"PIC with BLANK WHEN ZERO": {
+			input: []byte("           05  RECORD          PIC 9(05)V99 BLANK WHEN ZERO.            "),
+			expected: []*Record{
+				{
+					Line:          2,
+					Level:         5,
+					Identifier:    "RECORD",
+					Pic:           Picture{PicString: "9(05)V99", PicType: Decimal, PicCount: 7, IntegerDigits: 5, FractionDigits: 2},
+					BlankWhenZero: true,
+				},
+			},
+		},
+		"PIC with BLANK ZEROES": {
+			input: []byte("           05  RECORD          PIC ZZ9.99 BLANK ZEROES.                 "),
+			expected: []*Record{
+				{
+					Line:          2,
+					Level:         5,
+					Identifier:    "RECORD",
+					Pic:           Picture{PicString: "ZZ9.99", PicType: NumericEdited, PicCount: 6, IntegerDigits: 3, FractionDigits: 2, Edited: true, EditMask: "ZZ9.99"},
+					BlankWhenZero: true,
+				},
+			},
+		},
+		"OCCURS before usage and PIC": {
+			input: []byte("           05  RECORD          OCCURS 3 COMP-3 PIC S9(5).               "),
+			expected: []*Record{
+				{
+					Line:          2,
+					Level:         5,
+					Identifier:    "RECORD",
+					Pic:           Picture{PicString: "S9(5)", PicType: Signed, PicCount: 6, IntegerDigits: 5, Signed: true},
+					Usage:         PackedDecimal,
+					UsageExplicit: true,
+					OccursCount:   3,
+				},
+			},
+		},
+		"USAGE IS DISPLAY before PICTURE IS": {
+			input: []byte("           05  RECORD          USAGE IS DISPLAY PICTURE IS X(05).       "),
+			expected: []*Record{
+				{
+					Line:          2,
+					Level:         5,
+					Identifier:    "RECORD",
+					Pic:           Picture{PicString: "X(05)", PicType: Alpha, PicCount: 5},
+					UsageExplicit: true,
+				},
+			},
+		},
+		"Clauses in any order across lines": {
+			input: []byte(`           05  RECORD          SYNC                                     
+                               OCCURS 2 TIMES INDEXED                   
+                               BY RECORD-IDX                            
+                               PIC 9(04) USAGE BINARY.                  
+`),
+			expected: []*Record{
+				{
+					Line:          2,
+					Level:         5,
+					Identifier:    "RECORD",
+					Pic:           Picture{PicString: "9(04)", PicType: Unsigned, PicCount: 4, IntegerDigits: 4},
+					Usage:         Binary,
+					UsageExplicit: true,
+					OccursCount:   2,
+					Synchronized:  true,
+					Indexes:       []string{"RECORD-IDX"},
 				},
 			},
 		},
@@ -204,6 +674,7 @@ func Test_ParseIndividualRecord(t *testing.T) {
 			input: []byte("           05  RECORD               REDEFINES RECORD.           "),
 			expected: []*Record{
 				{
+					Line:       2,
 					Level:      5,
 					Identifier: "RECORD",
 					Redefines:  "RECORD",
@@ -216,6 +687,7 @@ func Test_ParseIndividualRecord(t *testing.T) {
 `),
 			expected: []*Record{
 				{
+					Line:       2,
 					Level:      3,
 					Identifier: "RECORD",
 					Redefines:  "RECORD-2",
@@ -229,10 +701,13 @@ func Test_ParseIndividualRecord(t *testing.T) {
 `),
 			expected: []*Record{
 				{
-					Level:      7,
-					Identifier: "RECORD",
-					Redefines:  "RECORD-2",
-					Pic:        Picture{PicString: "S9(13)", PicType: Signed, PicCount: 14},
+					Line:          2,
+					Level:         7,
+					Identifier:    "RECORD",
+					Redefines:     "RECORD-2",
+					Pic:           Picture{PicString: "S9(13)", PicType: Signed, PicCount: 14, IntegerDigits: 13, Signed: true},
+					Usage:         PackedDecimal,
+					UsageExplicit: true,
 				},
 			},
 		},
@@ -240,6 +715,7 @@ func Test_ParseIndividualRecord(t *testing.T) {
 			input: []byte("       15  RECORD               OCCURS 4.                          "),
 			expected: []*Record{
 				{
+					Line:        2,
 					Level:       15,
 					Identifier:  "RECORD",
 					OccursCount: 4,
@@ -250,6 +726,7 @@ func Test_ParseIndividualRecord(t *testing.T) {
 			input: []byte("       15  RECORD               PIC X(40) OCCURS 4.                "),
 			expected: []*Record{
 				{
+					Line:        2,
 					Level:       15,
 					Identifier:  "RECORD",
 					Pic:         Picture{PicString: "X(40)", PicType: Alpha, PicCount: 40},
@@ -261,6 +738,7 @@ func Test_ParseIndividualRecord(t *testing.T) {
 			input: []byte("       15  RECORD               PIC X(40) OCCURS 4 TIMES.          "),
 			expected: []*Record{
 				{
+					Line:        2,
 					Level:       15,
 					Identifier:  "RECORD",
 					Pic:         Picture{PicString: "X(40)", PicType: Alpha, PicCount: 40},
@@ -274,6 +752,7 @@ func Test_ParseIndividualRecord(t *testing.T) {
 `),
 			expected: []*Record{
 				{
+					Line:        2,
 					Level:       10,
 					Identifier:  "RECORD",
 					Pic:         Picture{PicString: "X(40)", PicType: Alpha, PicCount: 40},
@@ -288,9 +767,86 @@ func Test_ParseIndividualRecord(t *testing.T) {
 `),
 			expected: []*Record{
 				{
+					Line:        2,
 					Level:       5,
 					Identifier:  "RECORD-6",
 					OccursCount: 10,
+					Indexes:     []string{"X-:XXXX:-DBT"},
+				},
+			},
+		},
+		"OCCURS with keys and indexes": {
+			input: []byte(`           05  RATES           OCCURS 10 TIMES                          
+                               ASCENDING KEY IS RATE-ID RATE-DATE       
+                               DESCENDING RATE-SEQ                      
+                               INDEXED BY RATE-IDX RATE-IDX-2           
+                               PIC X(20).                               
+`),
+			expected: []*Record{
+				{
+					Line:        2,
+					Level:       5,
+					Identifier:  "RATES",
+					Pic:         Picture{PicString: "X(20)", PicType: Alpha, PicCount: 20},
+					OccursCount: 10,
+					Keys: []Key{
+						{Identifier: "RATE-ID"},
+						{Identifier: "RATE-DATE"},
+						{Identifier: "RATE-SEQ", Descending: true},
+					},
+					Indexes: []string{"RATE-IDX", "RATE-IDX-2"},
+				},
+			},
+		},
+		"OCCURS with key followed by clause": {
+			input: []byte("           05  CODES           OCCURS 5 ASCENDING CODES PIC 9(3) COMP-3.  "),
+			expected: []*Record{
+				{
+					Line:          2,
+					Level:         5,
+					Identifier:    "CODES",
+					Pic:           Picture{PicString: "9(3)", PicType: Unsigned, PicCount: 3, IntegerDigits: 3},
+					Usage:         PackedDecimal,
+					UsageExplicit: true,
+					OccursCount:   5,
+					Keys:          []Key{{Identifier: "CODES"}},
+				},
+			},
+		},
+		"USAGE POINTER": {
+			input: []byte("           05  RECORD                          USAGE POINTER.           "),
+			expected: []*Record{
+				{
+					Line:          2,
+					Level:         5,
+					Identifier:    "RECORD",
+					Usage:         Pointer,
+					UsageExplicit: true,
+				},
+			},
+		},
+		"USAGE IS INDEX with OCCURS": {
+			input: []byte("           05  RECORD          USAGE IS INDEX  OCCURS 3 TIMES.          "),
+			expected: []*Record{
+				{
+					Line:          2,
+					Level:         5,
+					Identifier:    "RECORD",
+					Usage:         Index,
+					UsageExplicit: true,
+					OccursCount:   3,
+				},
+			},
+		},
+		"PROCEDURE-POINTER without USAGE": {
+			input: []byte("           05  RECORD                          PROCEDURE-POINTER.       "),
+			expected: []*Record{
+				{
+					Line:          2,
+					Level:         5,
+					Identifier:    "RECORD",
+					Usage:         ProcedurePointer,
+					UsageExplicit: true,
 				},
 			},
 		},
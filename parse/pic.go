@@ -23,17 +23,58 @@ const (
 	Decimal // decimal
 	// Alpha represents an alphanumeric string (e.g. X(5)).
 	Alpha // alpha
+	// NumericEdited represents a number formatted for display (e.g. ZZ,ZZ9.99-).
+	NumericEdited // numericedited
+	// AlphaEdited represents an alphanumeric string formatted for display (e.g. XXBXX).
+	AlphaEdited // alphaedited
+	// National represents a UTF-16 string (e.g. N(5)).
+	National // national
+	// DBCS represents a double-byte character set string (e.g. G(5)).
+	DBCS // dbcs
 
+	nationalIndicators  = "N"
+	dbcsIndicators      = "G"
 	alphaIndicators     = "XA"
 	decimalIndicators   = ".VP"
 	signedIntIndicators = "S"
 	intIndicators       = "9"
+	// numericEditedIndicators are the editing symbols that cannot be read as a plain number. A
+	// single sign or decimal point does not make a numeric PIC definition edited in this sense.
+	numericEditedIndicators = "Z*$,B0/"
+	alphaEditedIndicators   = "B0/"
+	// floatingSymbols are the editing symbols that, when repeated, hold a digit in every position
+	// but the first.
+	floatingSymbols = "$+-"
+)
+
+// DoubleByteCharacterWidth is the number of bytes taken by each national or DBCS character.
+const DoubleByteCharacterWidth = 2
+
+const (
+	// editingSymbols are the insertion, replacement and sign symbols of numeric-edited and
+	// alphanumeric-edited PIC definitions.
+	editingSymbols = "Z*$+-,.B0/"
+	// signSymbols are the symbols that make a PIC definition signed.
+	signSymbols = "S+-"
+	// creditSymbol and debitSymbol are the two-character sign symbols of numeric-edited PIC definitions.
+	creditSymbol = "CR"
+	debitSymbol  = "DB"
 )
 
 // zeroWidthIndicatorRegex matches field type indicators that do not
 // contribute to width.
 var zeroWidthIndicatorRegex = regexp.MustCompile(`V|P(?:\(\d+\))?`)
 
+// IsNumeric returns true if the PIC type holds digits rather than characters.
+func (i PicType) IsNumeric() bool {
+	switch i {
+	case Unsigned, Signed, Decimal, NumericEdited:
+		return true
+	default:
+		return false
+	}
+}
+
 func PicTypeFromString(s string) (PicType, error) {
 	picType, err := PicTypeString(strings.ToLower(s))
 	if err != nil {
@@ -45,10 +86,28 @@ func PicTypeFromString(s string) (PicType, error) {
 // parsePICType identifies an equivalent Go type from the given substring
 // that contains a PIC definition.
 func parsePICType(s string) PicType {
+	if strings.ContainsAny(s, nationalIndicators) {
+		return National
+	}
+
+	if strings.ContainsAny(s, dbcsIndicators) {
+		return DBCS
+	}
+
+	expanded := expandPIC(s)
 	if strings.ContainsAny(s, alphaIndicators) {
+		if strings.ContainsAny(expanded, alphaEditedIndicators) {
+			return AlphaEdited
+		}
 		return Alpha
 	}
 
+	if strings.ContainsAny(expanded, numericEditedIndicators) ||
+		strings.Contains(s, creditSymbol) || strings.Contains(s, debitSymbol) ||
+		strings.Count(expanded, "+") > 1 || strings.Count(expanded, "-") > 1 {
+		return NumericEdited
+	}
+
 	if strings.ContainsAny(s, decimalIndicators) {
 		return Decimal
 	}
@@ -70,7 +129,12 @@ func parsePICType(s string) PicType {
 // S9(5)V9(7): "S" = 1, "9(5)" = 5, "V" = 0, "9(7)" = 7 => 19
 // S9(5).9(7): "S" = 1, "9(5)" = 5, "." = 1, "9(7)" = 7 => 20
 // PPP9(5): "PPP" = 0, "9(5)" = 5 => 5
+// Editing symbols each take one position, apart from "CR" and "DB" which take two:
+// $$$,$$9.99CR: "$$$" = 3, "," = 1, "$$9" = 3, "." = 1, "99" = 2, "CR" = 2 => 12
+// National and DBCS characters take two bytes each:
+// N(5): "N(5)" = 5 * 2 => 10
 func parsePICCount(s string) int {
+	original := s
 	// Remove indicators that do not contribute to the width.
 	s = zeroWidthIndicatorRegex.ReplaceAllString(s, "")
 
@@ -90,6 +154,98 @@ func parsePICCount(s string) int {
 		size += amount
 		s = s[:start] + s[end:]
 	}
+	size += len(s)
+
+	if isDoubleByte(original) {
+		return size * DoubleByteCharacterWidth
+	}
+
+	return size
+}
+
+// isDoubleByte identifies whether the given PIC definition holds national or DBCS characters.
+func isDoubleByte(s string) bool {
+	return strings.ContainsAny(s, nationalIndicators+dbcsIndicators)
+}
+
+// parsePICDigits identifies the number of digit positions before and after the
+// assumed decimal point of the given PIC definition, and its scale factor. The
+// scale factor is the number of "P" scaling positions, which is positive when they
+// follow the digits (the value is multiplied by a power of ten) and negative when
+// they precede them (the value is divided by a power of ten).
+// For example:
+// S9(5)V99: 5 integer digits, 2 fraction digits, scale factor 0
+// 9(03).9(4)-: 3 integer digits, 4 fraction digits, scale factor 0
+// 99PPP: 2 integer digits, 0 fraction digits, scale factor 3 => 99000
+// PPP99: 0 integer digits, 2 fraction digits, scale factor -3 => .00099
+// Zero suppression symbols hold a digit, as do floating insertion symbols in every position but the first:
+// $$$,$$9.99-: 5 integer digits, 2 fraction digits, scale factor 0
+func parsePICDigits(s string) (int, int, int) {
+	integerDigits, fractionDigits, leadingScaling, trailingScaling := 0, 0, 0, 0
+	afterPoint, seenDigit := false, false
+	seenFloating := make(map[rune]bool)
+	for _, r := range expandPIC(s) {
+		isDigit := r == '9' || r == 'Z' || r == '*'
+		if strings.ContainsRune(floatingSymbols, r) {
+			isDigit = seenFloating[r]
+			seenFloating[r] = true
+		}
+
+		switch {
+		case isDigit:
+			seenDigit = true
+			if afterPoint {
+				fractionDigits++
+			} else {
+				integerDigits++
+			}
+		case r == 'P':
+			if seenDigit {
+				trailingScaling++
+			} else {
+				// Leading scaling positions are always to the right of the assumed decimal point.
+				leadingScaling++
+				afterPoint = true
+			}
+		case r == 'V' || r == '.':
+			afterPoint = true
+		}
+	}
+
+	return integerDigits, fractionDigits, trailingScaling - leadingScaling
+}
+
+// parsePICSigned identifies whether the given PIC definition can hold a negative value.
+func parsePICSigned(s string) bool {
+	return strings.ContainsAny(s, signSymbols) || strings.Contains(s, creditSymbol) || strings.Contains(s, debitSymbol)
+}
+
+// parsePICEdited identifies whether the given PIC definition contains editing symbols
+// that are inserted into, or replace, the digits or characters of the value.
+func parsePICEdited(s string) bool {
+	return strings.ContainsAny(expandPIC(s), editingSymbols) ||
+		strings.Contains(s, creditSymbol) || strings.Contains(s, debitSymbol)
+}
+
+// expandPIC expands the repetition factors in a PIC definition, e.g. 9(3)V9(2) becomes 999V99.
+func expandPIC(s string) string {
+	var expanded strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '(' || i == 0 {
+			expanded.WriteByte(s[i])
+			continue
+		}
+
+		end := strings.IndexByte(s[i:], ')')
+		if end < 0 {
+			return expanded.String()
+		}
+		if count, err := strconv.Atoi(s[i+1 : i+end]); err == nil && count > 0 {
+			// The repeated symbol has already been written once.
+			expanded.WriteString(strings.Repeat(string(s[i-1]), count-1))
+		}
+		i += end
+	}
 
-	return size + len(s)
+	return expanded.String()
 }
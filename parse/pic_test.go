@@ -17,6 +17,10 @@ func TestPicTypeFromString(t *testing.T) {
 		"Signed":           {"signed", Signed, assert.NoError},
 		"Decimal":          {"decimal", Decimal, assert.NoError},
 		"Unknown":          {"unknown", Unknown, assert.NoError},
+		"Numeric edited":   {"numericedited", NumericEdited, assert.NoError},
+		"Alpha edited":     {"alphaedited", AlphaEdited, assert.NoError},
+		"National":         {"national", National, assert.NoError},
+		"DBCS":             {"dbcs", DBCS, assert.NoError},
 		"Case insensitive": {"AlPhA", Alpha, assert.NoError},
 		"Invalid":          {"invalid", 0, assert.Error},
 		"Empty string":     {"", 0, assert.Error},
@@ -45,6 +49,17 @@ func Test_parsePICType(t *testing.T) {
 		"Signed integer":           {"S9(5)", Signed},
 		"Unsigned integer":         {"9(9)", Unsigned},
 		"Complex decimal":          {"S9(5)V99", Decimal},
+		"Zero suppression":         {"ZZ,ZZ9.99-", NumericEdited},
+		"Floating currency":        {"$$$,$$9.99", NumericEdited},
+		"Check protection":         {"***9.99", NumericEdited},
+		"Credit":                   {"9(5)CR", NumericEdited},
+		"Floating minus":           {"---9", NumericEdited},
+		"Date with slashes":        {"99/99/99", NumericEdited},
+		"Repeated zero count":      {"9(10)", Unsigned},
+		"Alpha edited":             {"XXBXX/XX0", AlphaEdited},
+		"Alpha edited with count":  {"X(3)BX(10)", AlphaEdited},
+		"National":                 {"N(20)", National},
+		"DBCS":                     {"G(10)", DBCS},
 		"Unknown":                  {"?", Unknown},
 		"Empty string":             {"", Unknown},
 	}
@@ -73,6 +88,14 @@ func Test_parsePICCount(t *testing.T) {
 		"Mixed format":             {"S9(5)V99", 8},
 		"Complex format":           {"S9(5)V9(2)", 8},
 		"Complex format with sign": {"9(03).9(4)-", 9},
+		"Zero suppression":         {"ZZ,ZZ9.99-", 10},
+		"Floating currency":        {"$$$,$$9.99", 10},
+		"Credit":                   {"$(3),$$9.99CR", 12},
+		"Date with slashes":        {"99/99/99", 8},
+		"Alpha edited with count":  {"X(3)BX(10)", 14},
+		"National":                 {"N(20)", 40},
+		"National repeated":        {"NNN", 6},
+		"DBCS":                     {"G(10)", 20},
 		"Invalid format":           {"X(A)", -1},
 		"Empty string":             {"", 0},
 	}
@@ -84,3 +107,98 @@ func Test_parsePICCount(t *testing.T) {
 		})
 	}
 }
+
+func Test_parsePICDigits(t *testing.T) {
+	tests := map[string]struct {
+		Input                  string
+		ExpectedIntegerDigits  int
+		ExpectedFractionDigits int
+		ExpectedScaleFactor    int
+	}{
+		"Integer":                  {"9(5)", 5, 0, 0},
+		"Signed integer":           {"S9(5)", 5, 0, 0},
+		"Implied decimal":          {"S9(5)V99", 5, 2, 0},
+		"Implied decimal repeated": {"9(15)V9(2)", 15, 2, 0},
+		"Decimal period with sign": {"9(03).9(4)-", 3, 4, 0},
+		"Scaling factor start":     {"PPP99", 0, 2, -3},
+		"Scaling factor after V":   {"VP(3)99", 0, 2, -3},
+		"Scaling factor end":       {"99PPP", 2, 0, 3},
+		"Zero suppression":         {"ZZ,ZZ9.99-", 5, 2, 0},
+		"Floating currency":        {"$$$,$$9.99", 5, 2, 0},
+		"Check protection":         {"***9.99", 4, 2, 0},
+		"Floating plus":            {"+++9", 3, 0, 0},
+		"Empty string":             {"", 0, 0, 0},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			integerDigits, fractionDigits, scaleFactor := parsePICDigits(tt.Input)
+			assert.Equal(t, tt.ExpectedIntegerDigits, integerDigits)
+			assert.Equal(t, tt.ExpectedFractionDigits, fractionDigits)
+			assert.Equal(t, tt.ExpectedScaleFactor, scaleFactor)
+		})
+	}
+}
+
+func Test_parsePICSigned(t *testing.T) {
+	tests := map[string]struct {
+		Input    string
+		Expected bool
+	}{
+		"Unsigned":       {"9(5)", false},
+		"Signed":         {"S9(5)V99", true},
+		"Trailing minus": {"9(03).9(4)-", true},
+		"Leading plus":   {"+9(5)", true},
+		"Credit":         {"9(5)CR", true},
+		"Debit":          {"9(5)DB", true},
+		"Alpha":          {"X(5)", false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, parsePICSigned(tt.Input))
+		})
+	}
+}
+
+func Test_parsePICEdited(t *testing.T) {
+	tests := map[string]struct {
+		Input    string
+		Expected bool
+	}{
+		"Unsigned":              {"9(10)", false},
+		"Implied decimal":       {"S9(5)V99", false},
+		"Scaling factor":        {"PPP99", false},
+		"Decimal period":        {"9(03).9(4)-", true},
+		"Zero suppression":      {"ZZ9", true},
+		"Credit":                {"9(5)CR", true},
+		"Alpha":                 {"X(10)", false},
+		"Alpha with insertions": {"XXBXX/XX0", true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, parsePICEdited(tt.Input))
+		})
+	}
+}
+
+func Test_expandPIC(t *testing.T) {
+	tests := map[string]struct {
+		Input    string
+		Expected string
+	}{
+		"No repetition":       {"99V99", "99V99"},
+		"Repetition":          {"S9(3)V9(2)", "S999V99"},
+		"Multi-digit count":   {"X(10)", "XXXXXXXXXX"},
+		"Unterminated":        {"9(3", "9"},
+		"Invalid count":       {"X(A)", "X"},
+		"Leading parenthesis": {"(3)", "(3)"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, expandPIC(tt.Input))
+		})
+	}
+}
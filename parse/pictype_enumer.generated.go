@@ -6,9 +6,9 @@ import (
 	"fmt"
 )
 
-const _PicTypeName = "unknownunsignedsigneddecimalalpha"
+const _PicTypeName = "unknownunsignedsigneddecimalalphanumericeditedalphaeditednationaldbcs"
 
-var _PicTypeIndex = [...]uint8{0, 7, 15, 21, 28, 33}
+var _PicTypeIndex = [...]uint8{0, 7, 15, 21, 28, 33, 46, 57, 65, 69}
 
 func (i PicType) String() string {
 	if i < 0 || i >= PicType(len(_PicTypeIndex)-1) {
@@ -17,7 +17,7 @@ func (i PicType) String() string {
 	return _PicTypeName[_PicTypeIndex[i]:_PicTypeIndex[i+1]]
 }
 
-var _PicTypeValues = []PicType{0, 1, 2, 3, 4}
+var _PicTypeValues = []PicType{0, 1, 2, 3, 4, 5, 6, 7, 8}
 
 var _PicTypeNameToValueMap = map[string]PicType{
 	_PicTypeName[0:7]:   0,
@@ -25,6 +25,10 @@ var _PicTypeNameToValueMap = map[string]PicType{
 	_PicTypeName[15:21]: 2,
 	_PicTypeName[21:28]: 3,
 	_PicTypeName[28:33]: 4,
+	_PicTypeName[33:46]: 5,
+	_PicTypeName[46:57]: 6,
+	_PicTypeName[57:65]: 7,
+	_PicTypeName[65:69]: 8,
 }
 
 // PicTypeString retrieves an enum value from the enum constants string name.
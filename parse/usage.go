@@ -0,0 +1,93 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Usage defines the different USAGE clauses of a record.
+//
+//go:generate enumer -type Usage -output "usage_enumer.generated.go" -linecomment
+type Usage int
+
+const (
+	// Display represents data stored as characters, which is the default usage.
+	Display Usage = iota // display
+	// Index represents an index into a table (e.g. USAGE INDEX).
+	Index // index
+	// Pointer represents the address of a data item (e.g. USAGE POINTER).
+	Pointer // pointer
+	// ProcedurePointer represents the entry point of a program (e.g. USAGE PROCEDURE-POINTER).
+	ProcedurePointer // procedure-pointer
+	// FunctionPointer represents the entry point of a function (e.g. USAGE FUNCTION-POINTER).
+	FunctionPointer // function-pointer
+	// Binary represents a big-endian binary number limited by its PIC clause (e.g. USAGE COMP).
+	Binary // binary
+	// NativeBinary represents a big-endian binary number limited by its storage size (e.g. USAGE COMP-5).
+	NativeBinary // comp-5
+	// Float represents a single-precision hexadecimal floating-point number (e.g. USAGE COMP-1).
+	Float // comp-1
+	// Double represents a double-precision hexadecimal floating-point number (e.g. USAGE COMP-2).
+	Double // comp-2
+	// PackedDecimal represents a number with two digits per byte and a trailing sign (e.g. USAGE COMP-3).
+	PackedDecimal // packed-decimal
+)
+
+// usageKeywords maps the keywords of a USAGE clause, including their synonyms, to a Usage.
+var usageKeywords = map[string]Usage{
+	"DISPLAY":           Display,
+	"INDEX":             Index,
+	"POINTER":           Pointer,
+	"PROCEDURE-POINTER": ProcedurePointer,
+	"FUNCTION-POINTER":  FunctionPointer,
+	"BINARY":            Binary,
+	"COMP":              Binary,
+	"COMPUTATIONAL":     Binary,
+	"COMP-4":            Binary,
+	"COMPUTATIONAL-4":   Binary,
+	"COMP-5":            NativeBinary,
+	"COMPUTATIONAL-5":   NativeBinary,
+	"COMP-1":            Float,
+	"COMPUTATIONAL-1":   Float,
+	"COMP-2":            Double,
+	"COMPUTATIONAL-2":   Double,
+	"COMP-3":            PackedDecimal,
+	"COMPUTATIONAL-3":   PackedDecimal,
+	"PACKED-DECIMAL":    PackedDecimal,
+}
+
+// IsNumeric returns true if the usage stores a number in binary or packed decimal, which can only be
+// declared for records with a numeric PIC clause.
+func (i Usage) IsNumeric() bool {
+	switch i {
+	case Binary, NativeBinary, PackedDecimal:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsOpaque returns true if the usage holds an address or index that is only meaningful to the
+// program that set it. Records with an opaque usage have no PIC clause.
+func (i Usage) IsOpaque() bool {
+	switch i {
+	case Index, Pointer, ProcedurePointer, FunctionPointer:
+		return true
+	default:
+		return false
+	}
+}
+
+func getUsageClauseDetails(usage any) (Usage, error) {
+	usageString, ok := usage.(string)
+	if !ok {
+		return Display, fmt.Errorf("usage is not a string: %v", usage)
+	}
+
+	u, ok := usageKeywords[strings.ToUpper(usageString)]
+	if !ok {
+		return Display, fmt.Errorf("%q must be a valid usage: %v", usageString, UsageValues())
+	}
+
+	return u, nil
+}
@@ -0,0 +1,56 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getUsageClauseDetails(t *testing.T) {
+	tests := map[string]struct {
+		Input       any
+		Expected    Usage
+		AssertError assert.ErrorAssertionFunc
+	}{
+		"Pointer":           {"POINTER", Pointer, assert.NoError},
+		"Procedure pointer": {"PROCEDURE-POINTER", ProcedurePointer, assert.NoError},
+		"Function pointer":  {"FUNCTION-POINTER", FunctionPointer, assert.NoError},
+		"Index":             {"INDEX", Index, assert.NoError},
+		"Display":           {"DISPLAY", Display, assert.NoError},
+		"Comp":              {"COMP", Binary, assert.NoError},
+		"Computational-4":   {"COMPUTATIONAL-4", Binary, assert.NoError},
+		"Comp-5":            {"COMP-5", NativeBinary, assert.NoError},
+		"Comp-1":            {"COMP-1", Float, assert.NoError},
+		"Comp-2":            {"COMP-2", Double, assert.NoError},
+		"Packed decimal":    {"PACKED-DECIMAL", PackedDecimal, assert.NoError},
+		"Lower case":        {"comp-3", PackedDecimal, assert.NoError},
+		"Invalid usage":     {"POINTER-32", Display, assert.Error},
+		"Invalid type":      {12, Display, assert.Error},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := getUsageClauseDetails(tt.Input)
+			tt.AssertError(t, err)
+			assert.Equal(t, tt.Expected, result)
+		})
+	}
+}
+
+func TestUsage_IsNumeric(t *testing.T) {
+	assert.False(t, Display.IsNumeric())
+	assert.True(t, Binary.IsNumeric())
+	assert.True(t, NativeBinary.IsNumeric())
+	assert.True(t, PackedDecimal.IsNumeric())
+	assert.False(t, Float.IsNumeric())
+	assert.False(t, Pointer.IsNumeric())
+}
+
+func TestUsage_IsOpaque(t *testing.T) {
+	assert.False(t, Display.IsOpaque())
+	assert.True(t, Index.IsOpaque())
+	assert.True(t, Pointer.IsOpaque())
+	assert.True(t, ProcedurePointer.IsOpaque())
+	assert.True(t, FunctionPointer.IsOpaque())
+	assert.False(t, Binary.IsOpaque())
+}